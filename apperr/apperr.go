@@ -0,0 +1,111 @@
+// Package apperr holds the domain errors returned by the repositories and
+// services. The handlers map each type to an HTTP status with errors.As, so
+// they can be wrapped freely on the way up.
+package apperr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NotFoundError reports a missing resource. Err is usually sql.ErrNoRows,
+// errors.Is keeps matching it.
+type NotFoundError struct {
+	Resource string
+	Err      error
+}
+
+func NotFound(resource string, err error) error {
+	return &NotFoundError{Resource: resource, Err: err}
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Resource + " not found"
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// ConflictError reports a write clashing with the current state, e.g. a
+// unique constraint.
+type ConflictError struct {
+	Message string
+	Err     error
+}
+
+func Conflict(message string, err error) error {
+	return &ConflictError{Message: message, Err: err}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// FieldViolation is a rule broken by a single input field.
+type FieldViolation struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError reports input the domain rejects, with every violation
+// found rather than the first one.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func Validation(violations ...FieldViolation) error {
+	return &ValidationError{Violations: violations}
+}
+
+func (e *ValidationError) Error() string {
+	details := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		details[i] = fmt.Sprintf("%s %s", v.Field, v.Message)
+	}
+	return "validation failed: " + strings.Join(details, ", ")
+}
+
+// UnauthorizedError reports missing or invalid credentials.
+type UnauthorizedError struct {
+	Message string
+}
+
+func Unauthorized(message string) error {
+	return &UnauthorizedError{Message: message}
+}
+
+func (e *UnauthorizedError) Error() string {
+	return e.Message
+}
+
+// ForbiddenError reports an authenticated caller lacking a permission.
+type ForbiddenError struct {
+	Message string
+}
+
+func Forbidden(message string) error {
+	return &ForbiddenError{Message: message}
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+// PreconditionFailedError reports a conditional request whose condition no
+// longer holds, e.g. a stale version.
+type PreconditionFailedError struct {
+	Message string
+}
+
+func PreconditionFailed(message string) error {
+	return &PreconditionFailedError{Message: message}
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return e.Message
+}
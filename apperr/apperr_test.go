@@ -0,0 +1,71 @@
+package apperr
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors(t *testing.T) {
+	tcs := map[string]struct {
+		givenErr error
+		expMsg   string
+	}{
+		"not found": {
+			givenErr: NotFound("product", sql.ErrNoRows),
+			expMsg:   "product not found",
+		},
+		"conflict": {
+			givenErr: Conflict("username already taken", nil),
+			expMsg:   "username already taken",
+		},
+		"validation": {
+			givenErr: Validation(
+				FieldViolation{Field: "name", Message: "must not be empty"},
+				FieldViolation{Field: "price", Message: "must be positive"},
+			),
+			expMsg: "validation failed: name must not be empty, price must be positive",
+		},
+		"unauthorized": {
+			givenErr: Unauthorized("invalid token"),
+			expMsg:   "invalid token",
+		},
+		"forbidden": {
+			givenErr: Forbidden("admin only"),
+			expMsg:   "admin only",
+		},
+		"precondition failed": {
+			givenErr: PreconditionFailed("product was modified"),
+			expMsg:   "product was modified",
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// When
+			err := fmt.Errorf("wrapped: %w", tc.givenErr)
+
+			// Then
+			require.EqualError(t, tc.givenErr, tc.expMsg)
+			require.ErrorIs(t, err, tc.givenErr)
+		})
+	}
+}
+
+func TestNotFound_Unwrap(t *testing.T) {
+	// Given
+	err := fmt.Errorf("get product: %w", NotFound("product", sql.ErrNoRows))
+
+	// When
+	var notFound *NotFoundError
+	ok := errors.As(err, &notFound)
+
+	// Then
+	require.True(t, ok)
+	require.Equal(t, "product", notFound.Resource)
+	// callers matching the cause keep working
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// JWTAuth signs tokens with a single key and verifies them against a set of
+// keys selected by the kid header, so that tokens signed by a rotated out key
+// stay valid until they expire.
+type JWTAuth struct {
+	signKey    jwk.Key
+	verifyKeys jwk.Set
+}
+
+// New returns a JWTAuth signing with signKey. Tokens signed by signKey or
+// any of verifyKeys are accepted. Every key needs a kid and an alg.
+func New(signKey jwk.Key, verifyKeys ...jwk.Key) (*JWTAuth, error) {
+	set := jwk.NewSet()
+	for _, key := range append([]jwk.Key{signKey}, verifyKeys...) {
+		if key.KeyID() == "" || key.Algorithm().String() == "" {
+			return nil, errors.New("auth: key without kid or alg")
+		}
+		if _, ok := set.LookupKeyID(key.KeyID()); ok {
+			return nil, fmt.Errorf("auth: duplicate kid %s", key.KeyID())
+		}
+
+		// verification only needs the public part
+		pub, err := key.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("auth: key %s: %w", key.KeyID(), err)
+		}
+		if err := set.AddKey(pub); err != nil {
+			return nil, err
+		}
+	}
+
+	return &JWTAuth{
+		signKey:    signKey,
+		verifyKeys: set,
+	}, nil
+}
+
+func (ja *JWTAuth) Encode(claims map[string]interface{}) (jwt.Token, string, error) {
+	t := jwt.New()
+	for k, v := range claims {
+		if err := t.Set(k, v); err != nil {
+			return nil, "", err
+		}
+	}
+
+	payload, err := jwt.Sign(t, jwt.WithKey(ja.signKey.Algorithm(), ja.signKey))
+	if err != nil {
+		return nil, "", err
+	}
+	return t, string(payload), nil
+}
+
+// Decode parses and checks the signature of a token, without validating
+// its claims.
+func (ja *JWTAuth) Decode(tokenString string) (jwt.Token, error) {
+	return jwt.Parse([]byte(tokenString), jwt.WithKeySet(ja.verifyKeys), jwt.WithValidate(false))
+}
+
+// Verifier works like jwtauth.Verifier, so jwtauth.Authenticator and
+// jwtauth.FromContext can be used downstream.
+func (ja *JWTAuth) Verifier() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := ja.verifyRequest(r)
+			next.ServeHTTP(w, r.WithContext(jwtauth.NewContext(r.Context(), token, err)))
+		})
+	}
+}
+
+func (ja *JWTAuth) verifyRequest(r *http.Request) (jwt.Token, error) {
+	tokenString := jwtauth.TokenFromHeader(r)
+	if tokenString == "" {
+		tokenString = jwtauth.TokenFromCookie(r)
+	}
+	if tokenString == "" {
+		return nil, jwtauth.ErrNoTokenFound
+	}
+
+	token, err := ja.Decode(tokenString)
+	if err != nil {
+		return token, jwtauth.ErrorReason(err)
+	}
+
+	if err := jwt.Validate(token); err != nil {
+		return token, jwtauth.ErrorReason(err)
+	}
+
+	return token, nil
+}
+
+// PublicKeys returns the verification keys to publish as a JWKS. Symmetric
+// keys are never published.
+func (ja *JWTAuth) PublicKeys() jwk.Set {
+	set := jwk.NewSet()
+	for i := 0; i < ja.verifyKeys.Len(); i++ {
+		key, _ := ja.verifyKeys.Key(i)
+		if key.KeyType() == jwa.OctetSeq {
+			continue
+		}
+		set.AddKey(key)
+	}
+	return set
+}
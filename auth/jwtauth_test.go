@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"chi-demo/config"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/stretchr/testify/require"
+)
+
+func rsaPEM(t *testing.T) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func ecPEM(t *testing.T, curve elliptic.Curve) []byte {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func TestJWTAuth_EncodeDecode(t *testing.T) {
+	rsaKey := rsaPEM(t)
+	ecKey := ecPEM(t, elliptic.P256())
+
+	type args struct {
+		givenConfig func(dir string) config.JWTConfig
+		expAlg      jwa.SignatureAlgorithm
+	}
+
+	tcs := map[string]args{
+		"HS256": {
+			givenConfig: func(dir string) config.JWTConfig {
+				return config.JWTConfig{Algorithm: "HS256", KeyID: "hs", Secret: "secret"}
+			},
+			expAlg: jwa.HS256,
+		},
+		"RS256": {
+			givenConfig: func(dir string) config.JWTConfig {
+				return config.JWTConfig{Algorithm: "RS256", KeyID: "rs", PrivateKey: string(rsaKey)}
+			},
+			expAlg: jwa.RS256,
+		},
+		"ES256 from file": {
+			givenConfig: func(dir string) config.JWTConfig {
+				path := filepath.Join(dir, "es.pem")
+				require.NoError(t, os.WriteFile(path, ecKey, 0o600))
+				return config.JWTConfig{Algorithm: "ES256", KeyID: "es", PrivateKeyFile: path}
+			},
+			expAlg: jwa.ES256,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			tokenAuth, err := NewFromConfig(tc.givenConfig(t.TempDir()))
+			require.NoError(t, err)
+
+			// When
+			_, tokenString, err := tokenAuth.Encode(map[string]interface{}{"user_id": "1"})
+			require.NoError(t, err)
+			token, err := tokenAuth.Decode(tokenString)
+
+			// Then
+			require.NoError(t, err)
+			userID, _ := token.Get("user_id")
+			require.Equal(t, "1", userID)
+			require.Equal(t, tc.expAlg, tokenAuth.signKey.Algorithm())
+		})
+	}
+}
+
+func TestNewFromConfig_Errors(t *testing.T) {
+	tcs := map[string]struct {
+		givenConfig config.JWTConfig
+		expErr      string
+	}{
+		"unsupported algorithm": {
+			givenConfig: config.JWTConfig{Algorithm: "none", KeyID: "k"},
+			expErr:      `auth: unsupported algorithm "none"`,
+		},
+		"missing key id": {
+			givenConfig: config.JWTConfig{Algorithm: "HS256", Secret: "secret"},
+			expErr:      "auth: missing key id",
+		},
+		"missing secret": {
+			givenConfig: config.JWTConfig{Algorithm: "HS256", KeyID: "k"},
+			expErr:      "auth: missing secret for HS256",
+		},
+		"missing private key": {
+			givenConfig: config.JWTConfig{Algorithm: "RS256", KeyID: "k"},
+			expErr:      "auth: missing private key for RS256",
+		},
+		"key of another algorithm": {
+			givenConfig: config.JWTConfig{Algorithm: "RS256", KeyID: "k", PrivateKey: string(ecPEM(t, elliptic.P256()))},
+			expErr:      "auth: key k is not a RS256 key",
+		},
+		"unsupported curve": {
+			givenConfig: config.JWTConfig{Algorithm: "ES256", KeyID: "k", PrivateKey: string(ecPEM(t, elliptic.P384()))},
+			expErr:      "auth: key k: EC key must use the P-256 curve",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewFromConfig(tc.givenConfig)
+			require.EqualError(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestJWTAuth_Rotation(t *testing.T) {
+	// Given
+	oldKey, err := ParsePEMKey("old", jwa.RS256, rsaPEM(t))
+	require.NoError(t, err)
+	newKey, err := ParsePEMKey("new", jwa.ES256, ecPEM(t, elliptic.P256()))
+	require.NoError(t, err)
+	otherKey, err := ParsePEMKey("other", jwa.RS256, rsaPEM(t))
+	require.NoError(t, err)
+
+	before, err := New(oldKey)
+	require.NoError(t, err)
+	after, err := New(newKey, oldKey)
+	require.NoError(t, err)
+	other, err := New(otherKey)
+	require.NoError(t, err)
+
+	_, oldToken, err := before.Encode(map[string]interface{}{"user_id": "1"})
+	require.NoError(t, err)
+	_, otherToken, err := other.Encode(map[string]interface{}{"user_id": "1"})
+	require.NoError(t, err)
+
+	// When
+	_, oldErr := after.Decode(oldToken)
+	_, otherErr := after.Decode(otherToken)
+
+	// Then
+	require.NoError(t, oldErr)
+	require.Error(t, otherErr)
+}
+
+func TestJWTAuth_Verifier(t *testing.T) {
+	tokenAuth, err := NewFromConfig(config.JWTConfig{Algorithm: "HS256", KeyID: "hs", Secret: "secret"})
+	require.NoError(t, err)
+
+	_, valid, err := tokenAuth.Encode(map[string]interface{}{"exp": time.Now().Add(time.Minute)})
+	require.NoError(t, err)
+	_, expired, err := tokenAuth.Encode(map[string]interface{}{"exp": time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+
+	tcs := map[string]struct {
+		givenToken string
+		expErr     error
+	}{
+		"success": {
+			givenToken: valid,
+		},
+		"err - expired": {
+			givenToken: expired,
+			expErr:     jwtauth.ErrExpired,
+		},
+		"err - no token": {
+			expErr: jwtauth.ErrNoTokenFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products", nil)
+			if tc.givenToken != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.givenToken)
+			}
+			res := httptest.NewRecorder()
+
+			// When
+			var verifyErr error
+			handler := tokenAuth.Verifier()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _, verifyErr = jwtauth.FromContext(r.Context())
+			}))
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expErr, verifyErr)
+		})
+	}
+}
+
+func TestJWTAuth_PublicKeys(t *testing.T) {
+	// Given
+	signKey, err := ParsePEMKey("new", jwa.RS256, rsaPEM(t))
+	require.NoError(t, err)
+	secretKey, err := NewSecretKey("hs", []byte("secret"))
+	require.NoError(t, err)
+	tokenAuth, err := New(signKey, secretKey)
+	require.NoError(t, err)
+
+	// When
+	body, err := json.Marshal(tokenAuth.PublicKeys())
+	require.NoError(t, err)
+
+	// Then
+	set, err := jwk.Parse(body)
+	require.NoError(t, err)
+	require.Equal(t, 1, set.Len())
+	key, _ := set.Key(0)
+	require.Equal(t, "new", key.KeyID())
+	require.Equal(t, jwa.RS256, key.Algorithm())
+	// only the public part is published
+	_, hasPrivate := key.Get("d")
+	require.False(t, hasPrivate)
+}
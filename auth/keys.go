@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"chi-demo/config"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+// minRSABits is the smallest RSA key accepted for RS256
+const minRSABits = 2048
+
+// NewFromConfig loads the keys described by cfg.
+func NewFromConfig(cfg config.JWTConfig) (*JWTAuth, error) {
+	alg := jwa.SignatureAlgorithm(cfg.Algorithm)
+	if cfg.KeyID == "" {
+		return nil, errors.New("auth: missing key id")
+	}
+
+	var signKey jwk.Key
+	var err error
+	switch alg {
+	case jwa.HS256:
+		if cfg.Secret == "" {
+			return nil, errors.New("auth: missing secret for HS256")
+		}
+		signKey, err = NewSecretKey(cfg.KeyID, []byte(cfg.Secret))
+	case jwa.RS256, jwa.ES256:
+		pem := []byte(cfg.PrivateKey)
+		if len(pem) == 0 {
+			if cfg.PrivateKeyFile == "" {
+				return nil, fmt.Errorf("auth: missing private key for %s", alg)
+			}
+			if pem, err = os.ReadFile(cfg.PrivateKeyFile); err != nil {
+				return nil, fmt.Errorf("auth: %w", err)
+			}
+		}
+		signKey, err = ParsePEMKey(cfg.KeyID, alg, pem)
+	default:
+		return nil, fmt.Errorf("auth: unsupported algorithm %q", cfg.Algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	verifyKeys := make([]jwk.Key, 0, len(cfg.VerifyKeyFiles))
+	for kid, path := range cfg.VerifyKeyFiles {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("auth: %w", err)
+		}
+		key, err := ParsePEMKey(kid, "", pem)
+		if err != nil {
+			return nil, err
+		}
+		verifyKeys = append(verifyKeys, key)
+	}
+
+	return New(signKey, verifyKeys...)
+}
+
+// NewSecretKey returns an HS256 key.
+func NewSecretKey(kid string, secret []byte) (jwk.Key, error) {
+	key, err := jwk.FromRaw(secret)
+	if err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+	return withKeyID(key, kid, jwa.HS256)
+}
+
+// ParsePEMKey parses an RSA or EC key, public or private. An empty alg is
+// inferred from the key type.
+func ParsePEMKey(kid string, alg jwa.SignatureAlgorithm, pem []byte) (jwk.Key, error) {
+	key, err := jwk.ParseKey(pem, jwk.WithPEM(true))
+	if err != nil {
+		return nil, fmt.Errorf("auth: key %s: %w", kid, err)
+	}
+
+	var raw interface{}
+	if err := key.Raw(&raw); err != nil {
+		return nil, fmt.Errorf("auth: key %s: %w", kid, err)
+	}
+
+	var keyAlg jwa.SignatureAlgorithm
+	switch k := raw.(type) {
+	case *rsa.PrivateKey:
+		keyAlg, err = rsaAlgorithm(k.N.BitLen())
+	case *rsa.PublicKey:
+		keyAlg, err = rsaAlgorithm(k.N.BitLen())
+	case *ecdsa.PrivateKey:
+		keyAlg, err = ecAlgorithm(k.Curve)
+	case *ecdsa.PublicKey:
+		keyAlg, err = ecAlgorithm(k.Curve)
+	default:
+		err = fmt.Errorf("unsupported key type %T", raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("auth: key %s: %w", kid, err)
+	}
+
+	if alg != "" && alg != keyAlg {
+		return nil, fmt.Errorf("auth: key %s is not a %s key", kid, alg)
+	}
+
+	return withKeyID(key, kid, keyAlg)
+}
+
+func rsaAlgorithm(bits int) (jwa.SignatureAlgorithm, error) {
+	if bits < minRSABits {
+		return "", fmt.Errorf("RSA key must be at least %d bits", minRSABits)
+	}
+	return jwa.RS256, nil
+}
+
+func ecAlgorithm(curve elliptic.Curve) (jwa.SignatureAlgorithm, error) {
+	if curve != elliptic.P256() {
+		return "", errors.New("EC key must use the P-256 curve")
+	}
+	return jwa.ES256, nil
+}
+
+func withKeyID(key jwk.Key, kid string, alg jwa.SignatureAlgorithm) (jwk.Key, error) {
+	if err := key.Set(jwk.KeyIDKey, kid); err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+	if err := key.Set(jwk.AlgorithmKey, alg); err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	type args struct {
+		givenFile string
+		givenEnv  map[string]string
+		expConfig func() Config
+		expErr    string
+	}
+
+	tcs := map[string]args{
+		"success - defaults and env": {
+			givenEnv: map[string]string{
+				"JWT_SECRET": "secret",
+			},
+			expConfig: func() Config {
+				cfg := Default()
+				cfg.JWT.Secret = "secret"
+				return cfg
+			},
+		},
+		"success - env overrides file": {
+			givenFile: `
+server:
+  port: 8080
+  write_timeout: 1m
+database:
+  dsn: postgres://file
+  max_open_conns: 10
+  max_idle_conns: 5
+jwt:
+  secret: from-file
+features:
+  fuzzy_search: false
+`,
+			givenEnv: map[string]string{
+				"DATABASE_URL":         "postgres://env",
+				"JWT_VERIFY_KEY_FILES": "old=/keys/old.pem, older=/keys/older.pem",
+				"FEATURE_API_KEYS":     "false",
+				"TRACING_EXPORTER":     "otlp",
+				"TRACING_SAMPLE_RATIO": "0.25",
+			},
+			expConfig: func() Config {
+				cfg := Default()
+				cfg.Server.Port = 8080
+				cfg.Server.WriteTimeout = time.Minute
+				cfg.Database.DSN = "postgres://env"
+				cfg.Database.MaxOpenConns = 10
+				cfg.Database.MaxIdleConns = 5
+				cfg.JWT.Secret = "from-file"
+				cfg.JWT.VerifyKeyFiles = map[string]string{
+					"old":   "/keys/old.pem",
+					"older": "/keys/older.pem",
+				}
+				cfg.Features.FuzzySearch = false
+				cfg.Features.APIKeys = false
+				cfg.Tracing.Exporter = "otlp"
+				cfg.Tracing.SampleRatio = 0.25
+				return cfg
+			},
+		},
+		"err - unknown field in file": {
+			givenFile: `
+server:
+  prot: 8080
+`,
+			expErr: "yaml: unmarshal errors:\n  line 3: field prot not found in type config.ServerConfig",
+		},
+		"err - invalid env values": {
+			givenEnv: map[string]string{
+				"JWT_SECRET":          "secret",
+				"PORT":                "http",
+				"SERVER_IDLE_TIMEOUT": "2",
+			},
+			expErr: "config: PORT: invalid integer \"http\"\nconfig: SERVER_IDLE_TIMEOUT: invalid duration \"2\"",
+		},
+		"err - invalid config": {
+			givenEnv: map[string]string{
+				"DATABASE_URL": "",
+				"LOG_LEVEL":    "verbose",
+			},
+			expErr: "config: database.dsn is required\n" +
+				"config: jwt.secret is required for HS256\n" +
+				"config: log.level must be one of debug, info, warn or error, got \"verbose\"",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			var path string
+			if tc.givenFile != "" {
+				path = filepath.Join(t.TempDir(), "config.yaml")
+				require.NoError(t, os.WriteFile(path, []byte(tc.givenFile), 0o600))
+			}
+			for k, v := range tc.givenEnv {
+				t.Setenv(k, v)
+			}
+
+			// When
+			cfg, err := Load(path)
+
+			// Then
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expConfig(), cfg)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tcs := map[string]struct {
+		givenConfig func(cfg *Config)
+		expErr      string
+	}{
+		"success - RS256 key file": {
+			givenConfig: func(cfg *Config) {
+				cfg.JWT.Algorithm = "RS256"
+				cfg.JWT.PrivateKeyFile = "/keys/jwt.pem"
+			},
+		},
+		"err - missing RS256 key": {
+			givenConfig: func(cfg *Config) {
+				cfg.JWT.Algorithm = "RS256"
+			},
+			expErr: "config: jwt.private_key or jwt.private_key_file is required for RS256",
+		},
+		"err - unsupported algorithm": {
+			givenConfig: func(cfg *Config) {
+				cfg.JWT.Algorithm = "none"
+			},
+			expErr: "config: jwt.algorithm must be one of HS256, RS256 or ES256, got \"none\"",
+		},
+		"err - tracing": {
+			givenConfig: func(cfg *Config) {
+				cfg.Tracing.Exporter = "jaeger"
+				cfg.Tracing.SampleRatio = 2
+			},
+			expErr: "config: tracing.exporter must be one of none, stdout or otlp, got \"jaeger\"\n" +
+				"config: tracing.sample_ratio must be between 0 and 1",
+		},
+		"err - otlp without endpoint": {
+			givenConfig: func(cfg *Config) {
+				cfg.Tracing.Exporter = "otlp"
+				cfg.Tracing.Endpoint = ""
+			},
+			expErr: "config: tracing.endpoint is required for otlp",
+		},
+		"err - pool sizes": {
+			givenConfig: func(cfg *Config) {
+				cfg.Database.MaxOpenConns = 5
+				cfg.Database.MaxIdleConns = 10
+			},
+			expErr: "config: database.max_idle_conns must not exceed database.max_open_conns",
+		},
+		"err - page limits": {
+			givenConfig: func(cfg *Config) {
+				cfg.API.DefaultPageLimit = 50
+				cfg.API.MaxPageLimit = 10
+			},
+			expErr: "config: api.max_page_limit must not be lower than api.default_page_limit",
+		},
+		"err - body size": {
+			givenConfig: func(cfg *Config) {
+				cfg.API.MaxBodyBytes = 0
+			},
+			expErr: "config: api.max_body_bytes must be positive",
+		},
+		"err - scheduler interval": {
+			givenConfig: func(cfg *Config) {
+				cfg.Pricing.SchedulerInterval = 0
+			},
+			expErr: "config: pricing.scheduler_interval must be positive",
+		},
+		"err - readiness drain delay": {
+			givenConfig: func(cfg *Config) {
+				cfg.Server.ReadinessDrainDelay = -time.Second
+			},
+			expErr: "config: server.readiness_drain_delay must not be negative",
+		},
+		"err - timeouts": {
+			givenConfig: func(cfg *Config) {
+				cfg.Server.ReadTimeout = 0
+				cfg.Server.Port = 0
+			},
+			expErr: "config: server.port must be between 1 and 65535\nconfig: server.read_timeout must be positive",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			cfg := Default()
+			cfg.JWT.Secret = "secret"
+			tc.givenConfig(&cfg)
+
+			// When
+			err := cfg.Validate()
+
+			// Then
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
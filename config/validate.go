@@ -0,0 +1,75 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Validate reports every invalid setting at once.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("config: "+format, args...))
+		}
+	}
+	positive := func(name string, d time.Duration) {
+		check(d > 0, "%s must be positive", name)
+	}
+
+	check(c.Server.Port > 0 && c.Server.Port <= 65535, "server.port must be between 1 and 65535")
+	positive("server.read_timeout", c.Server.ReadTimeout)
+	positive("server.read_header_timeout", c.Server.ReadHeaderTimeout)
+	positive("server.write_timeout", c.Server.WriteTimeout)
+	positive("server.idle_timeout", c.Server.IdleTimeout)
+	check(c.Server.MaxHeaderBytes > 0, "server.max_header_bytes must be positive")
+	positive("server.shutdown_timeout", c.Server.ShutdownTimeout)
+	check(c.Server.ReadinessDrainDelay >= 0, "server.readiness_drain_delay must not be negative")
+
+	check(c.Database.DSN != "", "database.dsn is required")
+	check(c.Database.MaxOpenConns >= 0, "database.max_open_conns must not be negative")
+	check(c.Database.MaxIdleConns >= 0, "database.max_idle_conns must not be negative")
+	check(c.Database.MaxOpenConns == 0 || c.Database.MaxIdleConns <= c.Database.MaxOpenConns,
+		"database.max_idle_conns must not exceed database.max_open_conns")
+	check(c.Database.ConnMaxLifetime >= 0, "database.conn_max_lifetime must not be negative")
+	check(c.Database.ConnMaxIdleTime >= 0, "database.conn_max_idle_time must not be negative")
+
+	check(c.JWT.KeyID != "", "jwt.key_id is required")
+	switch c.JWT.Algorithm {
+	case "HS256":
+		check(c.JWT.Secret != "", "jwt.secret is required for HS256")
+	case "RS256", "ES256":
+		check(c.JWT.PrivateKey != "" || c.JWT.PrivateKeyFile != "",
+			"jwt.private_key or jwt.private_key_file is required for %s", c.JWT.Algorithm)
+	default:
+		check(false, "jwt.algorithm must be one of HS256, RS256 or ES256, got %q", c.JWT.Algorithm)
+	}
+
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		check(false, "log.level must be one of debug, info, warn or error, got %q", c.Log.Level)
+	}
+	check(c.Log.Format == "json" || c.Log.Format == "console",
+		"log.format must be json or console, got %q", c.Log.Format)
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		check(c.Tracing.Endpoint != "", "tracing.endpoint is required for otlp")
+	default:
+		check(false, "tracing.exporter must be one of none, stdout or otlp, got %q", c.Tracing.Exporter)
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1,
+		"tracing.sample_ratio must be between 0 and 1")
+
+	check(c.API.DefaultPageLimit > 0, "api.default_page_limit must be positive")
+	check(c.API.MaxPageLimit >= c.API.DefaultPageLimit,
+		"api.max_page_limit must not be lower than api.default_page_limit")
+	check(c.API.MaxBodyBytes > 0, "api.max_body_bytes must be positive")
+
+	positive("pricing.scheduler_interval", c.Pricing.SchedulerInterval)
+
+	return errors.Join(errs...)
+}
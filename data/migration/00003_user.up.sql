@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "user" (
+    id bigint primary key,
+    username varchar not null unique,
+    password_hash varchar not null,
+    created_at timestamptz not null,
+    updated_at timestamptz not null
+);
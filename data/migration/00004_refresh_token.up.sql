@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS refresh_token (
+    id bigint primary key,
+    user_id bigint not null references "user" (id) on delete cascade,
+    -- tokens rotated from the same login share a family
+    family_id bigint not null,
+    token_hash varchar not null unique,
+    expires_at timestamptz not null,
+    revoked_at timestamptz,
+    created_at timestamptz not null,
+    updated_at timestamptz not null
+);
+
+CREATE INDEX IF NOT EXISTS refresh_token_family_id_idx ON refresh_token (family_id);
+
+CREATE TABLE IF NOT EXISTS revoked_access_token (
+    jti varchar primary key,
+    -- rows can be dropped once the token would have expired anyway
+    expires_at timestamptz not null,
+    created_at timestamptz not null
+);
@@ -0,0 +1,3 @@
+-- self-registered users are viewers, editors and admins are promoted in the database
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS role varchar not null default 'viewer'
+    CHECK (role IN ('admin', 'editor', 'viewer'));
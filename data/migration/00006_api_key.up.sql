@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS api_key (
+    id bigint primary key,
+    name varchar not null,
+    -- only the sha256 of the key is kept, the key itself is shown once
+    key_hash varchar not null unique,
+    scopes text[] not null,
+    created_by bigint not null references "user" (id),
+    revoked_at timestamptz,
+    created_at timestamptz not null,
+    updated_at timestamptz not null
+);
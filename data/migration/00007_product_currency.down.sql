@@ -0,0 +1,2 @@
+ALTER TABLE product DROP COLUMN IF EXISTS currency;
+ALTER TABLE product ALTER COLUMN price TYPE integer;
@@ -0,0 +1,5 @@
+-- prices become amounts in minor units of a currency, the existing ones were
+-- US cents; supported currencies are checked by the application
+ALTER TABLE product ALTER COLUMN price TYPE bigint;
+ALTER TABLE product ADD COLUMN IF NOT EXISTS currency char(3) not null default 'USD'
+    CHECK (currency ~ '^[A-Z]{3}$');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS exchange_rate;
+DROP TABLE IF EXISTS product_price;
@@ -0,0 +1,29 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- explicit per-market prices, they win over converting the base price
+CREATE TABLE IF NOT EXISTS product_price (
+    id bigint primary key,
+    product_id bigint not null references product (id) ON DELETE CASCADE,
+    currency char(3) not null CHECK (currency ~ '^[A-Z]{3}$'),
+    amount bigint not null CHECK (amount > 0),
+    valid_from timestamptz not null,
+    -- null is open-ended
+    valid_to timestamptz,
+    created_at timestamptz not null,
+    CONSTRAINT product_price_validity_check CHECK (valid_to IS NULL OR valid_to > valid_from),
+    -- at most one price of a product per currency at any time
+    CONSTRAINT product_price_overlap_excl EXCLUDE USING gist (
+        product_id WITH =,
+        currency WITH =,
+        tstzrange(valid_from, valid_to) WITH &&
+    )
+);
+
+-- how many units of currency one unit of base is worth, all rows share the
+-- base and are replaced together
+CREATE TABLE IF NOT EXISTS exchange_rate (
+    currency char(3) primary key CHECK (currency ~ '^[A-Z]{3}$'),
+    base char(3) not null CHECK (base ~ '^[A-Z]{3}$'),
+    rate numeric(20, 10) not null CHECK (rate > 0),
+    updated_at timestamptz not null
+);
@@ -0,0 +1,22 @@
+-- the price history of the products: every change of product.price, applied
+-- ones have an applied_at, future-dated ones wait for the scheduler
+CREATE TABLE IF NOT EXISTS price_change (
+    id bigint primary key,
+    product_id bigint not null references product (id) ON DELETE CASCADE,
+    amount bigint not null CHECK (amount > 0),
+    currency char(3) not null CHECK (currency ~ '^[A-Z]{3}$'),
+    -- the price replaced, set once applied
+    previous_amount bigint,
+    previous_currency char(3),
+    reason varchar not null default '',
+    -- either a user or an API key made the change
+    changed_by_user_id bigint references "user" (id) ON DELETE SET NULL,
+    changed_by_api_key_id bigint references api_key (id) ON DELETE SET NULL,
+    effective_at timestamptz not null,
+    applied_at timestamptz,
+    created_at timestamptz not null
+);
+
+CREATE INDEX IF NOT EXISTS price_change_product_id_idx ON price_change (product_id, effective_at);
+-- the scheduler only looks for the pending changes
+CREATE INDEX IF NOT EXISTS price_change_pending_idx ON price_change (effective_at) WHERE applied_at IS NULL;
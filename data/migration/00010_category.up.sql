@@ -0,0 +1,24 @@
+-- the category taxonomy is a tree, root categories have no parent and
+-- descendants are walked with recursive queries
+CREATE TABLE IF NOT EXISTS category (
+    id bigint primary key,
+    -- a category with subcategories cannot be deleted
+    parent_id bigint references category (id) ON DELETE RESTRICT,
+    name varchar not null CHECK (name <> ''),
+    created_at timestamptz not null,
+    updated_at timestamptz not null,
+    CONSTRAINT category_parent_check CHECK (parent_id <> id)
+);
+
+CREATE INDEX IF NOT EXISTS category_parent_id_idx ON category (parent_id);
+-- sibling names are unique, case-insensitively, roots included
+CREATE UNIQUE INDEX IF NOT EXISTS category_parent_name_idx ON category (coalesce(parent_id, 0), lower(name));
+
+-- a product can be in several categories
+CREATE TABLE IF NOT EXISTS product_category (
+    product_id bigint not null references product (id) ON DELETE CASCADE,
+    category_id bigint not null references category (id) ON DELETE CASCADE,
+    primary key (product_id, category_id)
+);
+
+CREATE INDEX IF NOT EXISTS product_category_category_id_idx ON product_category (category_id);
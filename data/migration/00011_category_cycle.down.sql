@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS category_cycle_check ON category;
+DROP FUNCTION IF EXISTS category_check_cycle();
@@ -0,0 +1,27 @@
+-- a move is checked against the tree as it is once every earlier move has
+-- committed: the advisory lock serializes the moves and, under read committed,
+-- the ancestor query after it takes a fresh snapshot. A check in the update
+-- statement alone lets "a under b" and "b under a" both commit a cycle.
+CREATE OR REPLACE FUNCTION category_check_cycle() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('category_tree'));
+    IF EXISTS (
+        WITH RECURSIVE ancestor AS (
+            SELECT NEW.parent_id AS id
+            UNION
+            SELECT category.parent_id FROM category JOIN ancestor ON category.id = ancestor.id
+            WHERE category.parent_id IS NOT NULL
+        )
+        SELECT 1 FROM ancestor WHERE id = NEW.id
+    ) THEN
+        RAISE EXCEPTION 'category % would be its own ancestor', NEW.id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'category_cycle_check';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- moving to the root cannot close a cycle
+CREATE TRIGGER category_cycle_check BEFORE UPDATE OF parent_id ON category
+    FOR EACH ROW WHEN (NEW.parent_id IS NOT NULL AND NEW.parent_id IS DISTINCT FROM OLD.parent_id)
+    EXECUTE FUNCTION category_check_cycle();
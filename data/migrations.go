@@ -0,0 +1,41 @@
+package data
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// Migrations holds the golang-migrate files so the binary knows which schema
+// version it was built for.
+//
+//go:embed migration/*.sql
+var Migrations embed.FS
+
+// LatestMigration returns the version of the newest up migration.
+func LatestMigration() (int64, error) {
+	files, err := fs.Glob(Migrations, "migration/*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, file := range files {
+		name := strings.TrimPrefix(file, "migration/")
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return 0, fmt.Errorf("migration %s: missing version prefix", name)
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s: %w", name, err)
+		}
+		if version > latest {
+			latest = version
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,16 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestMigration(t *testing.T) {
+	// When
+	version, err := LatestMigration()
+
+	// Then
+	require.NoError(t, err)
+	require.Equal(t, int64(11), version)
+}
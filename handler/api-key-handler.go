@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/service"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// APIKeyHeader carries the API key of service-to-service requests.
+const APIKeyHeader = "X-API-Key"
+
+type APIKeyHandler struct {
+	apiKeyService service.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService service.APIKeyService) APIKeyHandler {
+	return APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+type apiKeyResponse struct {
+	ID        int64         `json:"id,string"`
+	Name      string        `json:"name"`
+	Scopes    []model.Scope `json:"scopes"`
+	CreatedBy int64         `json:"created_by,string"`
+	RevokedAt *time.Time    `json:"revoked_at,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	// Key is only set in the response to the creation
+	Key string `json:"key,omitempty"`
+}
+
+func toAPIKeyResponse(key model.APIKey) apiKeyResponse {
+	res := apiKeyResponse{
+		ID:        key.ID,
+		Name:      key.Name,
+		Scopes:    key.Scopes,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: key.CreatedAt,
+	}
+	if !key.RevokedAt.IsZero() {
+		res.RevokedAt = &key.RevokedAt
+	}
+	return res
+}
+
+func (apiKeyHandler APIKeyHandler) CreateAPIKey() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input apiKeyRequest
+		if err := decodeJSON(r, &input, "Invalid API key"); err != nil {
+			return err
+		}
+
+		principal, _ := PrincipalFromContext(r.Context())
+		inputKey := input.toAPIKey(principal.UserID)
+		if err := validateAPIKey(inputKey); err != nil {
+			return err
+		}
+
+		key, secret, err := apiKeyHandler.apiKeyService.Create(r.Context(), inputKey)
+		if err != nil {
+			return err
+		}
+
+		res := toAPIKeyResponse(key)
+		res.Key = secret
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(res)
+		return nil
+	})
+}
+
+func (apiKeyHandler APIKeyHandler) GetAPIKeys() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		keys, err := apiKeyHandler.apiKeyService.GetAll(r.Context())
+		if err != nil {
+			return err
+		}
+
+		data := make([]apiKeyResponse, 0, len(keys))
+		for _, key := range keys {
+			data = append(data, toAPIKeyResponse(key))
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:  data,
+			Total: int64(len(data)),
+		})
+		return nil
+	})
+}
+
+func (apiKeyHandler APIKeyHandler) RevokeAPIKey() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		err = apiKeyHandler.apiKeyService.Revoke(r.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        http.StatusOK,
+			Description: "API key revoked",
+		})
+		return nil
+	})
+}
+
+// Authenticate turns the X-API-Key header into the request principal.
+func (apiKeyHandler APIKeyHandler) Authenticate(next http.Handler) http.Handler {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		key, err := apiKeyHandler.apiKeyService.Authenticate(r.Context(), r.Header.Get(APIKeyHeader))
+		if err != nil {
+			return err
+		}
+
+		principal := model.Principal{
+			APIKeyID: key.ID,
+			Scopes:   key.Scopes,
+		}
+
+		next.ServeHTTP(w, r.WithContext(NewPrincipalContext(r.Context(), principal)))
+		return nil
+	})
+}
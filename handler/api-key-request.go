@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/validate"
+	"fmt"
+	"strings"
+)
+
+const maxAPIKeyNameLength = 64
+
+// apiKeyRequest is the payload creating an API key.
+type apiKeyRequest struct {
+	Name   string        `json:"name"`
+	Scopes []model.Scope `json:"scopes"`
+}
+
+func (req apiKeyRequest) toAPIKey(createdBy int64) model.APIKey {
+	return model.APIKey{
+		Name:      strings.TrimSpace(req.Name),
+		Scopes:    req.Scopes,
+		CreatedBy: createdBy,
+	}
+}
+
+// validateAPIKey reports every invalid field of key at once.
+func validateAPIKey(key model.APIKey) error {
+	var v validate.Validator
+	v.Field("name",
+		validate.Required(key.Name),
+		validate.MaxLength(key.Name, maxAPIKeyNameLength))
+	v.Field("scopes", func() string {
+		if len(key.Scopes) == 0 {
+			return "is required"
+		}
+		return ""
+	})
+	for i, scope := range key.Scopes {
+		v.Field(fmt.Sprintf("scopes[%d]", i), validate.OneOf(scope, model.Scopes...))
+	}
+	return invalidPayload(v.Err())
+}
@@ -0,0 +1,407 @@
+package handler
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/service"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CreateAPIKey(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockCreateService struct {
+		expCall bool
+		output  model.APIKey
+		secret  string
+		err     error
+	}
+
+	type args struct {
+		givenRequest      string
+		mockCreateService mockCreateService
+		expStatusCode     int
+		expResponse       string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"name":" batch ","scopes":["products:read"]}`,
+			mockCreateService: mockCreateService{
+				expCall: true,
+				output: model.APIKey{
+					ID:        1,
+					Name:      "batch",
+					Scopes:    []model.Scope{model.ScopeProductsRead},
+					CreatedBy: 1,
+					CreatedAt: createdAt,
+				},
+				secret: "pk_secret",
+			},
+			expStatusCode: http.StatusCreated,
+			expResponse:   `{"id":"1","name":"batch","scopes":["products:read"],"created_by":"1","created_at":"2023-01-01T00:00:00Z","key":"pk_secret"}`,
+		},
+		"err - invalid api key": {
+			givenRequest:  `[]`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid API key",
+			}),
+		},
+		"err - unknown member": {
+			givenRequest:  `{"name":"batch","scopes":["products:read"],"expires_at":"2030-01-01T00:00:00Z"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid API key",
+			}),
+		},
+		"err - missing field": {
+			givenRequest:  `{"name":"batch"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Validation failed: scopes is required",
+			}),
+		},
+		"err - name too long": {
+			givenRequest:  `{"name":"` + strings.Repeat("a", 65) + `","scopes":["products:read"]}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Validation failed: name must be at most 64 characters",
+			}),
+		},
+		"err - unknown scope": {
+			givenRequest:  `{"name":"batch","scopes":["products:admin"]}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Validation failed: scopes[0] must be one of products:read, products:write",
+			}),
+		},
+		"service error": {
+			givenRequest: `{"name":"batch","scopes":["products:read"]}`,
+			mockCreateService: mockCreateService{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/api-keys", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			req.Header.Set("Content-Type", "application/json")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			ctx = withRole(t, ctx, model.RoleAdmin)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockAPIKeyService := service.NewMockAPIKeyService(t)
+
+			// When
+			if tc.mockCreateService.expCall {
+				key := model.APIKey{
+					Name:      "batch",
+					Scopes:    []model.Scope{model.ScopeProductsRead},
+					CreatedBy: 1,
+				}
+				mockAPIKeyService.ExpectedCalls = []*mock.Call{
+					mockAPIKeyService.On("Create", ctx, key).Return(tc.mockCreateService.output, tc.mockCreateService.secret, tc.mockCreateService.err),
+				}
+			}
+			instance := NewAPIKeyHandler(mockAPIKeyService)
+			handler := instance.CreateAPIKey()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_GetAPIKeys(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockGetAllService struct {
+		output []model.APIKey
+		err    error
+	}
+
+	type args struct {
+		mockGetAllService mockGetAllService
+		expStatusCode     int
+		expResponse       string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			mockGetAllService: mockGetAllService{
+				output: []model.APIKey{
+					{
+						ID:        2,
+						Name:      "live",
+						Scopes:    []model.Scope{model.ScopeProductsRead},
+						CreatedBy: 1,
+						CreatedAt: createdAt,
+					},
+					{
+						ID:        1,
+						Name:      "revoked",
+						Scopes:    []model.Scope{model.ScopeProductsWrite},
+						CreatedBy: 1,
+						RevokedAt: createdAt,
+						CreatedAt: createdAt,
+					},
+				},
+			},
+			expStatusCode: http.StatusOK,
+			expResponse: `{"data":[
+				{"id":"2","name":"live","scopes":["products:read"],"created_by":"1","created_at":"2023-01-01T00:00:00Z"},
+				{"id":"1","name":"revoked","scopes":["products:write"],"created_by":"1","revoked_at":"2023-01-01T00:00:00Z","created_at":"2023-01-01T00:00:00Z"}
+			],"total":2}`,
+		},
+		"success - empty": {
+			expStatusCode: http.StatusOK,
+			expResponse:   `{"data":[],"total":0}`,
+		},
+		"service error": {
+			mockGetAllService: mockGetAllService{
+				err: errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/api-keys", nil)
+			routeCtx := chi.NewRouteContext()
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockAPIKeyService := service.NewMockAPIKeyService(t)
+
+			// When
+			mockAPIKeyService.ExpectedCalls = []*mock.Call{
+				mockAPIKeyService.On("GetAll", ctx).Return(tc.mockGetAllService.output, tc.mockGetAllService.err),
+			}
+			instance := NewAPIKeyHandler(mockAPIKeyService)
+			handler := instance.GetAPIKeys()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestHandler_RevokeAPIKey(t *testing.T) {
+	type mockRevokeService struct {
+		expCall bool
+		err     error
+	}
+
+	type args struct {
+		givenID           string
+		mockRevokeService mockRevokeService
+		expStatusCode     int
+		expResponse       string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID: "1",
+			mockRevokeService: mockRevokeService{
+				expCall: true,
+			},
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusOK,
+				Description: "API key revoked",
+			}),
+		},
+		"err - cannot convert id": {
+			givenID:       "abc",
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Cannot convert id to integer",
+			}),
+		},
+		"err - invalid id": {
+			givenID:       "-1",
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid id",
+			}),
+		},
+		"err - not found": {
+			givenID: "1",
+			mockRevokeService: mockRevokeService{
+				expCall: true,
+				err:     apperr.NotFound("API key", sql.ErrNoRows),
+			},
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "API key not found",
+			}),
+		},
+		"service error": {
+			givenID: "1",
+			mockRevokeService: mockRevokeService{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodDelete, "/api-keys/"+tc.givenID, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tc.givenID)
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockAPIKeyService := service.NewMockAPIKeyService(t)
+
+			// When
+			if tc.mockRevokeService.expCall {
+				mockAPIKeyService.ExpectedCalls = []*mock.Call{
+					mockAPIKeyService.On("Revoke", ctx, int64(1)).Return(tc.mockRevokeService.err),
+				}
+			}
+			instance := NewAPIKeyHandler(mockAPIKeyService)
+			handler := instance.RevokeAPIKey()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestHandler_AuthenticateAPIKey(t *testing.T) {
+	type mockAuthenticateService struct {
+		output model.APIKey
+		err    error
+	}
+
+	type args struct {
+		mockAuthenticateService mockAuthenticateService
+		expPrincipal            model.Principal
+		expStatusCode           int
+		expResponse             string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			mockAuthenticateService: mockAuthenticateService{
+				output: model.APIKey{
+					ID:     1,
+					Scopes: []model.Scope{model.ScopeProductsRead},
+				},
+			},
+			expPrincipal: model.Principal{
+				APIKeyID: 1,
+				Scopes:   []model.Scope{model.ScopeProductsRead},
+			},
+			expStatusCode: http.StatusOK,
+		},
+		"err - invalid api key": {
+			mockAuthenticateService: mockAuthenticateService{
+				err: service.ErrInvalidAPIKey,
+			},
+			expStatusCode: http.StatusUnauthorized,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid API key",
+			}),
+		},
+		"service error": {
+			mockAuthenticateService: mockAuthenticateService{
+				err: errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products", nil)
+			req.Header.Set(APIKeyHeader, "pk_secret")
+			ctx := req.Context()
+			res := httptest.NewRecorder()
+
+			mockAPIKeyService := service.NewMockAPIKeyService(t)
+
+			// When
+			mockAPIKeyService.ExpectedCalls = []*mock.Call{
+				mockAPIKeyService.On("Authenticate", ctx, "pk_secret").Return(tc.mockAuthenticateService.output, tc.mockAuthenticateService.err),
+			}
+			var principal model.Principal
+			instance := NewAPIKeyHandler(mockAPIKeyService)
+			handler := instance.Authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				principal, _ = PrincipalFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.Equal(t, tc.expPrincipal, principal)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
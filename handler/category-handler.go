@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"chi-demo/config"
+	"chi-demo/model"
+	"chi-demo/service"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type CategoryHandler struct {
+	categoryService service.CategoryService
+	cfg             config.Config
+}
+
+func NewCategoryHandler(categoryService service.CategoryService, cfg config.Config) CategoryHandler {
+	return CategoryHandler{
+		categoryService: categoryService,
+		cfg:             cfg,
+	}
+}
+
+// GetCategories returns the whole taxonomy as a flat list, clients rebuild
+// the tree from the parent ids.
+func (categoryHandler CategoryHandler) GetCategories() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		categories, err := categoryHandler.categoryService.GetAll(r.Context())
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:  toCategoryResponses(categories),
+			Total: int64(len(categories)),
+		})
+		return nil
+	})
+}
+
+func (categoryHandler CategoryHandler) GetCategory() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		category, err := categoryHandler.categoryService.GetOne(r.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toCategoryResponse(category))
+		return nil
+	})
+}
+
+func (categoryHandler CategoryHandler) CreateCategory() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input categoryRequest
+		if err := decodeJSON(r, &input, "Invalid category"); err != nil {
+			return err
+		}
+
+		inputCategory := input.toCategory()
+		if err := validateCategory(inputCategory); err != nil {
+			return err
+		}
+
+		category, err := categoryHandler.categoryService.Create(r.Context(), inputCategory)
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Location", fmt.Sprintf("/categories/%d", category.ID))
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(toCategoryResponse(category))
+		return nil
+	})
+}
+
+// UpdateCategory replaces a category, changing parent_id moves it with its
+// subcategories.
+func (categoryHandler CategoryHandler) UpdateCategory() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		var input categoryRequest
+		if err := decodeJSON(r, &input, "Invalid category"); err != nil {
+			return err
+		}
+
+		inputCategory := input.toCategory()
+		inputCategory.ID = id
+		if err := validateCategory(inputCategory); err != nil {
+			return err
+		}
+
+		category, err := categoryHandler.categoryService.Update(r.Context(), inputCategory)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toCategoryResponse(category))
+		return nil
+	})
+}
+
+func (categoryHandler CategoryHandler) DeleteCategory() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		err = categoryHandler.categoryService.Delete(r.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        http.StatusOK,
+			Description: "Category deleted",
+		})
+		return nil
+	})
+}
+
+// GetCategoryProducts lists the products of a category and of its
+// subcategories, with the paging, filters and sort of GET /products.
+func (categoryHandler CategoryHandler) GetCategoryProducts() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		query, err := listQuery(r, categoryHandler.cfg.API)
+		if err != nil {
+			return err
+		}
+
+		page, err := categoryHandler.categoryService.GetProducts(r.Context(), id, query)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:       toProductResponses(page.Products),
+			Total:      page.Total,
+			NextCursor: encodeCursor(page.NextID),
+		})
+		return nil
+	})
+}
+
+func (categoryHandler CategoryHandler) GetProductCategories() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		categories, err := categoryHandler.categoryService.GetProductCategories(r.Context(), productID)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:  toCategoryResponses(categories),
+			Total: int64(len(categories)),
+		})
+		return nil
+	})
+}
+
+// SetProductCategories replaces the categories a product is assigned to, an
+// empty list unassigns it from all of them.
+func (categoryHandler CategoryHandler) SetProductCategories() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		var input productCategoriesRequest
+		if err := decodeJSON(r, &input, "Invalid product categories"); err != nil {
+			return err
+		}
+
+		categoryIDs, err := input.toCategoryIDs()
+		if err != nil {
+			return err
+		}
+
+		categories, err := categoryHandler.categoryService.SetProductCategories(r.Context(), productID, categoryIDs)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:  toCategoryResponses(categories),
+			Total: int64(len(categories)),
+		})
+		return nil
+	})
+}
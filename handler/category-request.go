@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/validate"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxCategoryNameLength = 100
+	maxProductCategories  = 50
+)
+
+// categoryRequest is the payload creating or replacing a category. A
+// missing or null parent_id makes it a root category.
+type categoryRequest struct {
+	Name     string `json:"name"`
+	ParentID *int64 `json:"parent_id,string"`
+}
+
+func (req categoryRequest) toCategory() model.Category {
+	category := model.Category{
+		Name: strings.TrimSpace(req.Name),
+	}
+	if req.ParentID != nil {
+		category.ParentID = *req.ParentID
+	}
+	return category
+}
+
+// validateCategory reports every invalid field of category at once.
+func validateCategory(category model.Category) error {
+	var v validate.Validator
+	v.Field("name",
+		validate.Required(category.Name),
+		validate.MaxLength(category.Name, maxCategoryNameLength))
+	return invalidPayload(v.Err())
+}
+
+// productCategoriesRequest assigns a product to exactly these categories,
+// the ids are strings like in the responses.
+type productCategoriesRequest struct {
+	CategoryIDs []string `json:"category_ids"`
+}
+
+// toCategoryIDs parses the category ids, dropping duplicates. Every invalid
+// id is reported at once.
+func (req productCategoriesRequest) toCategoryIDs() ([]int64, error) {
+	var v validate.Validator
+	v.Field("category_ids", func() string {
+		if len(req.CategoryIDs) > maxProductCategories {
+			return fmt.Sprintf("must have at most %d categories", maxProductCategories)
+		}
+		return ""
+	})
+
+	ids := make([]int64, 0, len(req.CategoryIDs))
+	seen := make(map[int64]bool, len(req.CategoryIDs))
+	for i, raw := range req.CategoryIDs {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		v.Field(fmt.Sprintf("category_ids[%d]", i), func() string {
+			if err != nil || id <= 0 {
+				return "must be a category id"
+			}
+			return ""
+		})
+		if err == nil && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, invalidPayload(v.Err())
+}
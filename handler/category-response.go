@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"time"
+)
+
+// categoryResponse is the wire format of a category, parent_id is null for
+// a root category.
+type categoryResponse struct {
+	ID        int64     `json:"id,string"`
+	ParentID  *int64    `json:"parent_id,string"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func toCategoryResponse(category model.Category) categoryResponse {
+	res := categoryResponse{
+		ID:        category.ID,
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+	if category.ParentID != 0 {
+		res.ParentID = &category.ParentID
+	}
+	return res
+}
+
+func toCategoryResponses(categories []model.Category) []categoryResponse {
+	res := make([]categoryResponse, 0, len(categories))
+	for _, category := range categories {
+		res = append(res, toCategoryResponse(category))
+	}
+	return res
+}
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/config"
+	"chi-demo/model"
+	"chi-demo/service"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryHandler_CreateCategory(t *testing.T) {
+	type mockCreateService struct {
+		expCall     bool
+		expCategory model.Category
+		output      model.Category
+		err         error
+	}
+
+	type args struct {
+		givenRequest      string
+		mockCreateService mockCreateService
+		expStatusCode     int
+		expLocation       string
+		expResponse       string
+	}
+
+	created := model.Category{ID: 3, ParentID: 2, Name: "running"}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"name":" running ","parent_id":"2"}`,
+			mockCreateService: mockCreateService{
+				expCall:     true,
+				expCategory: model.Category{ParentID: 2, Name: "running"},
+				output:      created,
+			},
+			expStatusCode: http.StatusCreated,
+			expLocation:   "/categories/3",
+			expResponse:   ToJsonString(toCategoryResponse(created)),
+		},
+		"success - root": {
+			givenRequest: `{"name":"garden","parent_id":null}`,
+			mockCreateService: mockCreateService{
+				expCall:     true,
+				expCategory: model.Category{Name: "garden"},
+				output:      model.Category{ID: 5, Name: "garden"},
+			},
+			expStatusCode: http.StatusCreated,
+			expLocation:   "/categories/5",
+			expResponse:   `{"id":"5","parent_id":null,"name":"garden","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`,
+		},
+		"err - validation": {
+			givenRequest:  `{"name":" "}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Validation failed: name is required",
+			}),
+		},
+		"err - numeric parent id": {
+			givenRequest:  `{"name":"running","parent_id":2}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid category",
+			}),
+		},
+		"err - duplicate": {
+			givenRequest: `{"name":"running","parent_id":"2"}`,
+			mockCreateService: mockCreateService{
+				expCall:     true,
+				expCategory: model.Category{ParentID: 2, Name: "running"},
+				err:         apperr.Conflict("category name already exists under this parent", nil),
+			},
+			expStatusCode: http.StatusConflict,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusConflict,
+				Description: "Category name already exists under this parent",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(tc.givenRequest))
+			res := httptest.NewRecorder()
+
+			mockCategoryService := service.NewMockCategoryService(t)
+
+			// When
+			if tc.mockCreateService.expCall {
+				mockCategoryService.ExpectedCalls = []*mock.Call{
+					mockCategoryService.On("Create", req.Context(), tc.mockCreateService.expCategory).Return(tc.mockCreateService.output, tc.mockCreateService.err),
+				}
+			}
+			handler := NewCategoryHandler(mockCategoryService, config.Default()).CreateCategory()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.Equal(t, tc.expLocation, res.Header().Get("Location"))
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestCategoryHandler_GetCategoryProducts(t *testing.T) {
+	type mockGetProductsService struct {
+		expCall  bool
+		expQuery model.ProductListQuery
+		output   model.ProductPage
+		err      error
+	}
+
+	type args struct {
+		givenQuery             string
+		mockGetProductsService mockGetProductsService
+		expStatusCode          int
+		expResponse            string
+	}
+
+	page := model.ProductPage{
+		Products: []model.Product{{ID: 1, Name: "running shoes", Price: model.Money{Amount: 1000, Currency: "USD"}}},
+		Total:    1,
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenQuery: "?name[ilike]=shoe&sort=-price",
+			mockGetProductsService: mockGetProductsService{
+				expCall: true,
+				expQuery: model.ProductListQuery{
+					Limit:   20,
+					Filters: []model.ProductFilter{{Field: "name", Operator: model.FilterILike, Value: "shoe"}},
+					Sort:    []model.ProductSort{{Field: "price", Desc: true}},
+				},
+				output: page,
+			},
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.ListResponse{
+				Data:  toProductResponses(page.Products),
+				Total: 1,
+			}),
+		},
+		"err - not found": {
+			mockGetProductsService: mockGetProductsService{
+				expCall:  true,
+				expQuery: model.ProductListQuery{Limit: 20},
+				err:      apperr.NotFound("category", sql.ErrNoRows),
+			},
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "Category not found",
+			}),
+		},
+		"err - limit too large": {
+			givenQuery:    "?limit=101",
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid limit, must be between 1 and 100",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/categories/1/products"+tc.givenQuery, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			ctx = withRole(t, ctx, model.RoleEditor)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockCategoryService := service.NewMockCategoryService(t)
+
+			// When
+			if tc.mockGetProductsService.expCall {
+				mockCategoryService.ExpectedCalls = []*mock.Call{
+					mockCategoryService.On("GetProducts", ctx, int64(1), tc.mockGetProductsService.expQuery).Return(tc.mockGetProductsService.output, tc.mockGetProductsService.err),
+				}
+			}
+			handler := NewCategoryHandler(mockCategoryService, config.Default()).GetCategoryProducts()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestCategoryHandler_SetProductCategories(t *testing.T) {
+	type mockSetService struct {
+		expCall bool
+		expIDs  []int64
+		output  []model.Category
+		err     error
+	}
+
+	type args struct {
+		givenRequest   string
+		mockSetService mockSetService
+		expStatusCode  int
+		expResponse    string
+	}
+
+	categories := []model.Category{{ID: 4, ParentID: 1, Name: "shirts"}, {ID: 5, Name: "garden"}}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"category_ids":["5","4","5"]}`,
+			mockSetService: mockSetService{
+				expCall: true,
+				expIDs:  []int64{5, 4},
+				output:  categories,
+			},
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.ListResponse{
+				Data:  toCategoryResponses(categories),
+				Total: 2,
+			}),
+		},
+		"success - none": {
+			givenRequest: `{"category_ids":[]}`,
+			mockSetService: mockSetService{
+				expCall: true,
+				expIDs:  []int64{},
+			},
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.ListResponse{
+				Data: []categoryResponse{},
+			}),
+		},
+		"err - invalid ids": {
+			givenRequest:  `{"category_ids":["4","abc","0"]}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Validation failed: category_ids[1] must be a category id, category_ids[2] must be a category id",
+			}),
+		},
+		"err - unknown category": {
+			givenRequest: `{"category_ids":["1000"]}`,
+			mockSetService: mockSetService{
+				expCall: true,
+				expIDs:  []int64{1000},
+				err:     apperr.Validation(apperr.FieldViolation{Field: "category_ids", Message: "contains an unknown category"}),
+			},
+			expStatusCode: http.StatusUnprocessableEntity,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnprocessableEntity,
+				Description: "Validation failed: category_ids contains an unknown category",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPut, "/products/1/categories", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockCategoryService := service.NewMockCategoryService(t)
+
+			// When
+			if tc.mockSetService.expCall {
+				mockCategoryService.ExpectedCalls = []*mock.Call{
+					mockCategoryService.On("SetProductCategories", ctx, int64(1), tc.mockSetService.expIDs).Return(tc.mockSetService.output, tc.mockSetService.err),
+				}
+			}
+			handler := NewCategoryHandler(mockCategoryService, config.Default()).SetProductCategories()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+)
+
+// Cursors are opaque to clients: the base64url encoding of the id of the
+// last product of the previous page.
+
+func encodeCursor(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if id <= 0 {
+		return 0, errors.New("cursor id must be positive")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"chi-demo/apperr"
+	"errors"
+	"net/http"
+	"unicode"
+	"unicode/utf8"
+)
+
+// fromDomainErr maps the domain errors of the service to their HTTP status,
+// anything else is left to become a 500.
+func fromDomainErr(err error) (HandlerErr, bool) {
+	var (
+		notFound           *apperr.NotFoundError
+		conflict           *apperr.ConflictError
+		validation         *apperr.ValidationError
+		unauthorized       *apperr.UnauthorizedError
+		forbidden          *apperr.ForbiddenError
+		preconditionFailed *apperr.PreconditionFailedError
+	)
+
+	var code int
+	switch {
+	case errors.As(err, &notFound):
+		code = http.StatusNotFound
+		err = notFound
+	case errors.As(err, &conflict):
+		code = http.StatusConflict
+		err = conflict
+	case errors.As(err, &validation):
+		code = http.StatusUnprocessableEntity
+		err = validation
+	case errors.As(err, &unauthorized):
+		code = http.StatusUnauthorized
+		err = unauthorized
+	case errors.As(err, &forbidden):
+		code = http.StatusForbidden
+		err = forbidden
+	case errors.As(err, &preconditionFailed):
+		code = http.StatusPreconditionFailed
+		err = preconditionFailed
+	default:
+		return HandlerErr{}, false
+	}
+
+	herr := HandlerErr{
+		Code:        code,
+		Description: capitalize(err.Error()),
+	}
+	if validation != nil {
+		herr.Violations = validation.Violations
+	}
+	return herr, true
+}
+
+// capitalize turns an error message into a response description.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/service"
+	"encoding/json"
+	"net/http"
+)
+
+type HealthHandler struct {
+	healthService service.HealthService
+}
+
+func NewHealthHandler(healthService service.HealthService) HealthHandler {
+	return HealthHandler{
+		healthService: healthService,
+	}
+}
+
+// Healthz only tells the process is alive and serving, a failing dependency
+// must not get it restarted.
+func (healthHandler HealthHandler) Healthz() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeHealthReport(w, model.HealthReport{Status: model.HealthStatusOK})
+	}
+}
+
+// Readyz tells whether the instance should receive traffic, it replies 503
+// with the failing checks otherwise.
+func (healthHandler HealthHandler) Readyz() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeHealthReport(w, healthHandler.healthService.Readiness(r.Context()))
+	}
+}
+
+func writeHealthReport(w http.ResponseWriter, report model.HealthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Status != model.HealthStatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
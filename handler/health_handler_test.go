@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/service"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthHandler_Healthz(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	res := httptest.NewRecorder()
+
+	// When
+	instance := NewHealthHandler(service.NewMockHealthService(t))
+	instance.Healthz().ServeHTTP(res, req)
+
+	// Then
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "no-store", res.Header().Get("Cache-Control"))
+	require.JSONEq(t, `{"status":"ok"}`, res.Body.String())
+}
+
+func TestHealthHandler_Readyz(t *testing.T) {
+	type args struct {
+		mockReport    model.HealthReport
+		expStatusCode int
+		expResponse   string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			mockReport: model.HealthReport{
+				Status: model.HealthStatusOK,
+				Checks: []model.HealthCheck{
+					{Name: "database", Status: model.HealthStatusOK, LatencyMs: 1.5},
+				},
+			},
+			expStatusCode: http.StatusOK,
+			expResponse:   `{"status":"ok","checks":[{"name":"database","status":"ok","latency_ms":1.5}]}`,
+		},
+		"not ready": {
+			mockReport: model.HealthReport{
+				Status: model.HealthStatusFail,
+				Checks: []model.HealthCheck{
+					{Name: "shutdown", Status: model.HealthStatusFail, Error: "shutting down"},
+					{Name: "database", Status: model.HealthStatusOK, LatencyMs: 1.5},
+				},
+			},
+			expStatusCode: http.StatusServiceUnavailable,
+			expResponse: `{"status":"fail","checks":[` +
+				`{"name":"shutdown","status":"fail","latency_ms":0,"error":"shutting down"},` +
+				`{"name":"database","status":"ok","latency_ms":1.5}]}`,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, chi.NewRouteContext())
+			req = req.WithContext(ctx)
+			res := httptest.NewRecorder()
+
+			mockHealthService := service.NewMockHealthService(t)
+
+			// When
+			mockHealthService.ExpectedCalls = []*mock.Call{
+				mockHealthService.On("Readiness", ctx).Return(tc.mockReport),
+			}
+			instance := NewHealthHandler(mockHealthService)
+			instance.Readyz().ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.Equal(t, "application/json", res.Header().Get("Content-Type"))
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
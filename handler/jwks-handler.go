@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+// jwksMaxAge lets verifiers cache the keys, rotations must keep the previous
+// key around for at least this long
+const jwksMaxAge = "max-age=300"
+
+// JWKS publishes the token verification keys so other services can verify
+// our tokens.
+func JWKS(keys jwk.Set) http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		body, err := json.Marshal(keys)
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		w.Header().Set("Cache-Control", "public, "+jwksMaxAge)
+		w.Write(body)
+		return nil
+	})
+}
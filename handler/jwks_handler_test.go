@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_JWKS(t *testing.T) {
+	// Given
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key, err := jwk.FromRaw(raw.Public())
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.KeyIDKey, "test"))
+	require.NoError(t, key.Set(jwk.AlgorithmKey, jwa.ES256))
+	set := jwk.NewSet()
+	require.NoError(t, set.AddKey(key))
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	res := httptest.NewRecorder()
+
+	// When
+	JWKS(set).ServeHTTP(res, req)
+
+	// Then
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "application/jwk-set+json", res.Header().Get("Content-Type"))
+	require.Equal(t, "public, max-age=300", res.Header().Get("Cache-Control"))
+	published, err := jwk.Parse(res.Body.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, published.Len())
+	publishedKey, _ := published.Key(0)
+	require.Equal(t, "test", publishedKey.KeyID())
+	require.Equal(t, jwa.ES256, publishedKey.Algorithm())
+}
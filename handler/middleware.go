@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// Denylist rejects missing or invalid access tokens and the ones revoked by
+// a logout. It must be placed after the token verifier.
+func (userHandler UserHandler) Denylist(next http.Handler) http.Handler {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		token, _, err := jwtauth.FromContext(r.Context())
+		if err != nil || token == nil || token.JwtID() == "" {
+			return HandlerErr{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid token",
+			}
+		}
+
+		revoked, err := userHandler.userService.IsRevoked(r.Context(), token.JwtID())
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return HandlerErr{
+				Code:        http.StatusUnauthorized,
+				Description: "Token revoked",
+			}
+		}
+
+		next.ServeHTTP(w, r)
+		return nil
+	})
+}
+
+// RequireRole only lets through users whose role is one of roles. API keys
+// have no role and are always rejected.
+func RequireRole(roles ...model.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+			role := roleFromContext(r.Context())
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return nil
+				}
+			}
+
+			return HandlerErr{
+				Code:        http.StatusForbidden,
+				Description: "Forbidden",
+			}
+		})
+	}
+}
+
+// RequireScope only lets through principals granted scope, either by their
+// role or by their API key.
+func RequireScope(scope model.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+			principal, _ := PrincipalFromContext(r.Context())
+			if !principal.HasScope(scope) {
+				return HandlerErr{
+					Code:        http.StatusForbidden,
+					Description: "Forbidden",
+				}
+			}
+
+			next.ServeHTTP(w, r)
+			return nil
+		})
+	}
+}
+
+func roleFromContext(ctx context.Context) model.Role {
+	principal, _ := PrincipalFromContext(ctx)
+	return principal.Role
+}
+
+// userIDFromToken reads the user_id claim set by the user service.
+func userIDFromToken(token jwt.Token) (int64, bool) {
+	claim, ok := token.Get("user_id")
+	if !ok {
+		return 0, false
+	}
+	s, ok := claim.(string)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// LimitBody caps request bodies to n bytes, reading past it fails and is
+// answered with a 413 by the JSON decoding.
+func LimitBody(n int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, n)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/service"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// withRole puts a user principal with role into ctx, as the authentication
+// middleware would. An empty role leaves ctx untouched.
+func withRole(t *testing.T, ctx context.Context, role model.Role) context.Context {
+	if role == "" {
+		return ctx
+	}
+	return NewPrincipalContext(ctx, model.Principal{
+		UserID: 1,
+		Role:   role,
+		Scopes: model.RoleScopes[role],
+	})
+}
+
+func TestRequireRole(t *testing.T) {
+	type args struct {
+		givenRole     model.Role
+		givenAllowed  []model.Role
+		expStatusCode int
+		expResponse   string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRole:     model.RoleEditor,
+			givenAllowed:  []model.Role{model.RoleEditor, model.RoleAdmin},
+			expStatusCode: http.StatusOK,
+		},
+		"err - role not allowed": {
+			givenRole:     model.RoleViewer,
+			givenAllowed:  []model.Role{model.RoleEditor, model.RoleAdmin},
+			expStatusCode: http.StatusForbidden,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusForbidden,
+				Description: "Forbidden",
+			}),
+		},
+		"err - missing role": {
+			givenAllowed:  []model.Role{model.RoleViewer},
+			expStatusCode: http.StatusForbidden,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusForbidden,
+				Description: "Forbidden",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/products", nil)
+			ctx := withRole(t, req.Context(), tc.givenRole)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			// When
+			handler := RequireRole(tc.givenAllowed...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	type args struct {
+		givenPrincipal *model.Principal
+		givenScope     model.Scope
+		expStatusCode  int
+		expResponse    string
+	}
+
+	tcs := map[string]args{
+		"success - user role": {
+			givenPrincipal: &model.Principal{
+				UserID: 1,
+				Role:   model.RoleViewer,
+				Scopes: model.RoleScopes[model.RoleViewer],
+			},
+			givenScope:    model.ScopeProductsRead,
+			expStatusCode: http.StatusOK,
+		},
+		"success - api key": {
+			givenPrincipal: &model.Principal{
+				APIKeyID: 1,
+				Scopes:   []model.Scope{model.ScopeProductsWrite},
+			},
+			givenScope:    model.ScopeProductsWrite,
+			expStatusCode: http.StatusOK,
+		},
+		"err - scope not granted": {
+			givenPrincipal: &model.Principal{
+				UserID: 1,
+				Role:   model.RoleViewer,
+				Scopes: model.RoleScopes[model.RoleViewer],
+			},
+			givenScope:    model.ScopeProductsWrite,
+			expStatusCode: http.StatusForbidden,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusForbidden,
+				Description: "Forbidden",
+			}),
+		},
+		"err - missing principal": {
+			givenScope:    model.ScopeProductsRead,
+			expStatusCode: http.StatusForbidden,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusForbidden,
+				Description: "Forbidden",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products", nil)
+			ctx := req.Context()
+			if tc.givenPrincipal != nil {
+				ctx = NewPrincipalContext(ctx, *tc.givenPrincipal)
+			}
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			// When
+			handler := RequireScope(tc.givenScope)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestTokenPrincipal(t *testing.T) {
+	tokenAuth := jwtauth.New("HS256", []byte("secret"), nil)
+	expiresAt := time.Now().Add(time.Minute).Truncate(time.Second)
+
+	type args struct {
+		givenClaims   map[string]interface{}
+		expPrincipal  model.Principal
+		expStatusCode int
+		expResponse   string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenClaims: map[string]interface{}{
+				"user_id": "1",
+				"role":    "editor",
+				"jti":     "jti",
+				"exp":     expiresAt,
+			},
+			expPrincipal: model.Principal{
+				UserID:         1,
+				Role:           model.RoleEditor,
+				TokenID:        "jti",
+				TokenExpiresAt: expiresAt.UTC(),
+				Scopes:         []model.Scope{model.ScopeProductsRead, model.ScopeProductsWrite},
+			},
+			expStatusCode: http.StatusOK,
+		},
+		"err - missing user id": {
+			givenClaims: map[string]interface{}{
+				"role": "editor",
+			},
+			expStatusCode: http.StatusUnauthorized,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid token",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			token, _, err := tokenAuth.Encode(tc.givenClaims)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/products", nil)
+			ctx := jwtauth.NewContext(req.Context(), token, nil)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			// When
+			var principal model.Principal
+			handler := TokenPrincipal(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				principal, _ = PrincipalFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.Equal(t, tc.expPrincipal, principal)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Denylist(t *testing.T) {
+	tokenAuth := jwtauth.New("HS256", []byte("secret"), nil)
+
+	type mockIsRevokedService struct {
+		expCall bool
+		output  bool
+		err     error
+	}
+
+	type args struct {
+		givenClaims          map[string]interface{}
+		mockIsRevokedService mockIsRevokedService
+		expStatusCode        int
+		expResponse          string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenClaims: map[string]interface{}{
+				"jti": "jti",
+			},
+			mockIsRevokedService: mockIsRevokedService{
+				expCall: true,
+			},
+			expStatusCode: http.StatusOK,
+		},
+		"err - token without jti": {
+			givenClaims:   map[string]interface{}{},
+			expStatusCode: http.StatusUnauthorized,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid token",
+			}),
+		},
+		"err - revoked token": {
+			givenClaims: map[string]interface{}{
+				"jti": "jti",
+			},
+			mockIsRevokedService: mockIsRevokedService{
+				expCall: true,
+				output:  true,
+			},
+			expStatusCode: http.StatusUnauthorized,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnauthorized,
+				Description: "Token revoked",
+			}),
+		},
+		"service error": {
+			givenClaims: map[string]interface{}{
+				"jti": "jti",
+			},
+			mockIsRevokedService: mockIsRevokedService{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			token, _, err := tokenAuth.Encode(tc.givenClaims)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/products", nil)
+			ctx := jwtauth.NewContext(req.Context(), token, nil)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockUserService := service.NewMockUserService(t)
+
+			// When
+			if tc.mockIsRevokedService.expCall {
+				mockUserService.ExpectedCalls = []*mock.Call{
+					mockUserService.On("IsRevoked", ctx, "jti").Return(tc.mockIsRevokedService.output, tc.mockIsRevokedService.err),
+				}
+			}
+			instance := NewUserHandler(mockUserService)
+			handler := instance.Denylist(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/validate"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ChangeReasonHeader carries why a product update changes its price, it is
+// recorded in the price history.
+const ChangeReasonHeader = "X-Change-Reason"
+
+const maxChangeReasonLength = 255
+
+// changeNote says who changes the price of a product and why, from the
+// request principal and the X-Change-Reason header.
+func changeNote(r *http.Request) (model.ChangeNote, error) {
+	principal, _ := PrincipalFromContext(r.Context())
+	note := model.ChangeNote{
+		ChangedBy: principal.Actor(),
+		Reason:    strings.TrimSpace(r.Header.Get(ChangeReasonHeader)),
+	}
+
+	var v validate.Validator
+	v.Field(ChangeReasonHeader, validate.MaxLength(note.Reason, maxChangeReasonLength))
+	return note, invalidPayload(v.Err())
+}
+
+// priceChangeRequest is the payload scheduling a price change of a product.
+type priceChangeRequest struct {
+	Price       moneyRequest `json:"price"`
+	EffectiveAt time.Time    `json:"effective_at"`
+	Reason      string       `json:"reason"`
+}
+
+func (req priceChangeRequest) toPriceChange(productID int64, changedBy model.Actor) model.PriceChange {
+	return model.PriceChange{
+		ProductID: productID,
+		Price: model.Money{
+			Amount:   req.Price.Amount,
+			Currency: normalizeCurrency(req.Price.Currency),
+		},
+		ChangeNote: model.ChangeNote{
+			ChangedBy: changedBy,
+			Reason:    strings.TrimSpace(req.Reason),
+		},
+		EffectiveAt: req.EffectiveAt,
+	}
+}
+
+// validatePriceChange reports every invalid field of change at once.
+func validatePriceChange(change model.PriceChange) error {
+	var v validate.Validator
+	validateMoney(&v, "price", change.Price)
+	v.Field("effective_at", validate.Required(change.EffectiveAt))
+	v.Field("reason",
+		validate.Required(change.Reason),
+		validate.MaxLength(change.Reason, maxChangeReasonLength))
+	return invalidPayload(v.Err())
+}
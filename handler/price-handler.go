@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/service"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type PriceHandler struct {
+	priceService service.PriceService
+}
+
+func NewPriceHandler(priceService service.PriceService) PriceHandler {
+	return PriceHandler{
+		priceService: priceService,
+	}
+}
+
+func (priceHandler PriceHandler) GetPrices() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		prices, err := priceHandler.priceService.GetAll(r.Context(), productID)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:  toPriceResponses(prices),
+			Total: int64(len(prices)),
+		})
+		return nil
+	})
+}
+
+func (priceHandler PriceHandler) GetPrice() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		id, err := pathID(r, "priceID")
+		if err != nil {
+			return err
+		}
+
+		price, err := priceHandler.priceService.GetOne(r.Context(), productID, id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toPriceResponse(price))
+		return nil
+	})
+}
+
+func (priceHandler PriceHandler) CreatePrice() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		var input priceRequest
+		if err := decodeJSON(r, &input, "Invalid price"); err != nil {
+			return err
+		}
+
+		inputPrice := input.toProductPrice(productID, time.Now())
+		if err := validatePrice(inputPrice); err != nil {
+			return err
+		}
+
+		price, err := priceHandler.priceService.Create(r.Context(), inputPrice)
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Location", fmt.Sprintf("/products/%d/prices/%d", price.ProductID, price.ID))
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(toPriceResponse(price))
+		return nil
+	})
+}
+
+func (priceHandler PriceHandler) DeletePrice() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		id, err := pathID(r, "priceID")
+		if err != nil {
+			return err
+		}
+
+		err = priceHandler.priceService.Delete(r.Context(), productID, id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        http.StatusOK,
+			Description: "Price deleted",
+		})
+		return nil
+	})
+}
+
+func (priceHandler PriceHandler) GetExchangeRates() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		rates, err := priceHandler.priceService.GetExchangeRates(r.Context())
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toExchangeRatesResponse(rates))
+		return nil
+	})
+}
+
+// SetExchangeRates replaces the whole exchange rate table, typically loaded
+// from a daily reference rate feed.
+func (priceHandler PriceHandler) SetExchangeRates() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input exchangeRatesRequest
+		if err := decodeJSON(r, &input, "Invalid exchange rates"); err != nil {
+			return err
+		}
+
+		inputRates := input.toExchangeRates()
+		if err := validateExchangeRates(inputRates); err != nil {
+			return err
+		}
+
+		rates, err := priceHandler.priceService.SetExchangeRates(r.Context(), inputRates)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toExchangeRatesResponse(rates))
+		return nil
+	})
+}
+
+// GetPriceHistory returns the applied and scheduled price changes of a
+// product, latest first.
+func (priceHandler PriceHandler) GetPriceHistory() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		changes, err := priceHandler.priceService.GetPriceHistory(r.Context(), productID)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:  toPriceChangeResponses(changes),
+			Total: int64(len(changes)),
+		})
+		return nil
+	})
+}
+
+// GetPriceChange returns a single price change of a product.
+func (priceHandler PriceHandler) GetPriceChange() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		id, err := pathID(r, "changeID")
+		if err != nil {
+			return err
+		}
+
+		change, err := priceHandler.priceService.GetPriceChange(r.Context(), productID, id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toPriceChangeResponse(change))
+		return nil
+	})
+}
+
+// SchedulePriceChange stores a future-dated price change, the scheduler
+// applies it once effective_at is reached.
+func (priceHandler PriceHandler) SchedulePriceChange() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		var input priceChangeRequest
+		if err := decodeJSON(r, &input, "Invalid price change"); err != nil {
+			return err
+		}
+
+		principal, _ := PrincipalFromContext(r.Context())
+		inputChange := input.toPriceChange(productID, principal.Actor())
+		if err := validatePriceChange(inputChange); err != nil {
+			return err
+		}
+
+		change, err := priceHandler.priceService.SchedulePriceChange(r.Context(), inputChange)
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Location", fmt.Sprintf("/products/%d/price-changes/%d", change.ProductID, change.ID))
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(toPriceChangeResponse(change))
+		return nil
+	})
+}
+
+// CancelPriceChange removes a price change that is still scheduled.
+func (priceHandler PriceHandler) CancelPriceChange() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		productID, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		id, err := pathID(r, "changeID")
+		if err != nil {
+			return err
+		}
+
+		err = priceHandler.priceService.CancelPriceChange(r.Context(), productID, id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        http.StatusOK,
+			Description: "Price change cancelled",
+		})
+		return nil
+	})
+}
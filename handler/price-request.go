@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/validate"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// priceRequest is the payload adding a price to the price list of a
+// product. ValidFrom defaults to now and a missing ValidTo never expires.
+type priceRequest struct {
+	Price     moneyRequest `json:"price"`
+	ValidFrom *time.Time   `json:"valid_from"`
+	ValidTo   *time.Time   `json:"valid_to"`
+}
+
+func (req priceRequest) toProductPrice(productID int64, now time.Time) model.ProductPrice {
+	price := model.ProductPrice{
+		ProductID: productID,
+		Price: model.Money{
+			Amount:   req.Price.Amount,
+			Currency: normalizeCurrency(req.Price.Currency),
+		},
+		ValidFrom: now,
+	}
+	if req.ValidFrom != nil {
+		price.ValidFrom = *req.ValidFrom
+	}
+	if req.ValidTo != nil {
+		price.ValidTo = *req.ValidTo
+	}
+	return price
+}
+
+// validatePrice reports every invalid field of price at once.
+func validatePrice(price model.ProductPrice) error {
+	var v validate.Validator
+	validateMoney(&v, "price", price.Price)
+	v.Field("valid_to", func() string {
+		if !price.ValidTo.IsZero() && !price.ValidTo.After(price.ValidFrom) {
+			return "must be after valid_from"
+		}
+		return ""
+	})
+	return invalidPayload(v.Err())
+}
+
+// exchangeRatesRequest replaces the exchange rates, each rate is how many
+// units of its currency one unit of Base is worth, e.g. "1.08" USD for a
+// EUR base.
+type exchangeRatesRequest struct {
+	Base  string                     `json:"base"`
+	Rates map[string]decimal.Decimal `json:"rates"`
+}
+
+func (req exchangeRatesRequest) toExchangeRates() model.ExchangeRates {
+	rates := model.ExchangeRates{
+		Base:  normalizeCurrency(req.Base),
+		Rates: make(map[string]decimal.Decimal, len(req.Rates)),
+	}
+	for code, rate := range req.Rates {
+		rates.Rates[normalizeCurrency(code)] = rate
+	}
+	return rates
+}
+
+// validateExchangeRates reports every invalid field of rates at once.
+func validateExchangeRates(rates model.ExchangeRates) error {
+	var v validate.Validator
+	v.Field("base",
+		validate.Required(rates.Base),
+		validate.OneOf(rates.Base, model.SupportedCurrencies()...))
+	v.Field("rates", func() string {
+		if len(rates.Rates) == 0 {
+			return "is required"
+		}
+		return ""
+	})
+	// sorted so the violations come in a stable order
+	codes := make([]string, 0, len(rates.Rates))
+	for code := range rates.Rates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		rate := rates.Rates[code]
+		v.Field("rates."+code,
+			validate.OneOf(code, model.SupportedCurrencies()...),
+			func() string {
+				if code == rates.Base {
+					return "must not be the base currency"
+				}
+				return ""
+			},
+			func() string {
+				if !rate.IsPositive() {
+					return "must be positive"
+				}
+				return ""
+			})
+	}
+	return invalidPayload(v.Err())
+}
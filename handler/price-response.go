@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// priceResponse is the wire format of a price list entry, ids are strings
+// like the product ones.
+type priceResponse struct {
+	ID        int64         `json:"id,string"`
+	ProductID int64         `json:"product_id,string"`
+	Price     moneyResponse `json:"price"`
+	ValidFrom time.Time     `json:"valid_from"`
+	ValidTo   *time.Time    `json:"valid_to"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+func toPriceResponse(price model.ProductPrice) priceResponse {
+	res := priceResponse{
+		ID:        price.ID,
+		ProductID: price.ProductID,
+		Price:     toMoneyResponse(price.Price),
+		ValidFrom: price.ValidFrom,
+		CreatedAt: price.CreatedAt,
+	}
+	// open-ended prices have a zero ValidTo, sent as null
+	if !price.ValidTo.IsZero() {
+		res.ValidTo = &price.ValidTo
+	}
+	return res
+}
+
+func toPriceResponses(prices []model.ProductPrice) []priceResponse {
+	res := make([]priceResponse, 0, len(prices))
+	for _, price := range prices {
+		res = append(res, toPriceResponse(price))
+	}
+	return res
+}
+
+// localPriceResponse is the price of a product in the requested currency
+// with where it comes from.
+type localPriceResponse struct {
+	moneyResponse
+	Source model.PriceSource `json:"source"`
+}
+
+func toLocalPriceResponse(price model.LocalPrice) *localPriceResponse {
+	return &localPriceResponse{
+		moneyResponse: toMoneyResponse(price.Price),
+		Source:        price.Source,
+	}
+}
+
+// exchangeRatesResponse is the wire format of the exchange rates, the rates
+// are decimal strings so no precision is lost.
+type exchangeRatesResponse struct {
+	Base      string                     `json:"base"`
+	Rates     map[string]decimal.Decimal `json:"rates"`
+	UpdatedAt *time.Time                 `json:"updated_at"`
+}
+
+func toExchangeRatesResponse(rates model.ExchangeRates) exchangeRatesResponse {
+	res := exchangeRatesResponse{
+		Base:  rates.Base,
+		Rates: rates.Rates,
+	}
+	if res.Rates == nil {
+		res.Rates = map[string]decimal.Decimal{}
+	}
+	// never loaded rates have a zero UpdatedAt, sent as null
+	if !rates.UpdatedAt.IsZero() {
+		res.UpdatedAt = &rates.UpdatedAt
+	}
+	return res
+}
+
+// actorResponse is who made a change, only one of the ids is set.
+type actorResponse struct {
+	UserID   int64 `json:"user_id,string,omitempty"`
+	APIKeyID int64 `json:"api_key_id,string,omitempty"`
+}
+
+// priceChangeResponse is the wire format of a price history entry.
+type priceChangeResponse struct {
+	ID        int64         `json:"id,string"`
+	ProductID int64         `json:"product_id,string"`
+	Price     moneyResponse `json:"price"`
+	// PreviousPrice is null until the change is applied
+	PreviousPrice *moneyResponse          `json:"previous_price"`
+	Status        model.PriceChangeStatus `json:"status"`
+	Reason        string                  `json:"reason"`
+	ChangedBy     actorResponse           `json:"changed_by"`
+	EffectiveAt   time.Time               `json:"effective_at"`
+	AppliedAt     *time.Time              `json:"applied_at"`
+	CreatedAt     time.Time               `json:"created_at"`
+}
+
+func toPriceChangeResponse(change model.PriceChange) priceChangeResponse {
+	res := priceChangeResponse{
+		ID:        change.ID,
+		ProductID: change.ProductID,
+		Price:     toMoneyResponse(change.Price),
+		Status:    change.Status(),
+		Reason:    change.Reason,
+		ChangedBy: actorResponse{
+			UserID:   change.ChangedBy.UserID,
+			APIKeyID: change.ChangedBy.APIKeyID,
+		},
+		EffectiveAt: change.EffectiveAt,
+		CreatedAt:   change.CreatedAt,
+	}
+	// scheduled changes have a zero AppliedAt, sent as null
+	if !change.AppliedAt.IsZero() {
+		previous := toMoneyResponse(change.Previous)
+		res.PreviousPrice = &previous
+		res.AppliedAt = &change.AppliedAt
+	}
+	return res
+}
+
+func toPriceChangeResponses(changes []model.PriceChange) []priceChangeResponse {
+	res := make([]priceChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		res = append(res, toPriceChangeResponse(change))
+	}
+	return res
+}
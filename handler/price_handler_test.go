@@ -0,0 +1,623 @@
+package handler
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/service"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceHandler_CreatePrice(t *testing.T) {
+	type mockCreateService struct {
+		expCall  bool
+		expPrice model.ProductPrice
+		output   model.ProductPrice
+		err      error
+	}
+
+	type args struct {
+		givenRequest      string
+		mockCreateService mockCreateService
+		expStatusCode     int
+		expLocation       string
+		expResponse       string
+	}
+
+	validFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	validTo := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	created := model.ProductPrice{
+		ID:        2,
+		ProductID: 1,
+		Price:     model.Money{Amount: 899, Currency: "EUR"},
+		ValidFrom: validFrom,
+		ValidTo:   validTo,
+		CreatedAt: validFrom,
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"price":{"amount":899,"currency":"eur"},"valid_from":"2024-01-01T00:00:00Z","valid_to":"2024-02-01T00:00:00Z"}`,
+			mockCreateService: mockCreateService{
+				expCall: true,
+				expPrice: model.ProductPrice{
+					ProductID: 1,
+					Price:     model.Money{Amount: 899, Currency: "EUR"},
+					ValidFrom: validFrom,
+					ValidTo:   validTo,
+				},
+				output: created,
+			},
+			expStatusCode: http.StatusCreated,
+			expLocation:   "/products/1/prices/2",
+			expResponse:   ToJsonString(toPriceResponse(created)),
+		},
+		"err - invalid price": {
+			givenRequest:  `{"price":{"amount":899,"currency":"EUR"},"valid_from":"yesterday"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid price",
+			}),
+		},
+		"err - validation": {
+			givenRequest:  `{"price":{"amount":0,"currency":"XXX"},"valid_from":"2024-02-01T00:00:00Z","valid_to":"2024-01-01T00:00:00Z"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code: http.StatusBadRequest,
+				Description: "Validation failed: price.amount is required, " +
+					"price.currency must be one of CHF, EUR, GBP, JPY, USD, valid_to must be after valid_from",
+			}),
+		},
+		"err - overlapping": {
+			givenRequest: `{"price":{"amount":899,"currency":"EUR"},"valid_from":"2024-01-01T00:00:00Z","valid_to":"2024-02-01T00:00:00Z"}`,
+			mockCreateService: mockCreateService{
+				expCall: true,
+				expPrice: model.ProductPrice{
+					ProductID: 1,
+					Price:     model.Money{Amount: 899, Currency: "EUR"},
+					ValidFrom: validFrom,
+					ValidTo:   validTo,
+				},
+				err: apperr.Conflict("price overlaps another price in this currency", nil),
+			},
+			expStatusCode: http.StatusConflict,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusConflict,
+				Description: "Price overlaps another price in this currency",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/products/1/prices", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockPriceService := service.NewMockPriceService(t)
+
+			// When
+			if tc.mockCreateService.expCall {
+				mockPriceService.ExpectedCalls = []*mock.Call{
+					mockPriceService.On("Create", ctx, tc.mockCreateService.expPrice).Return(tc.mockCreateService.output, tc.mockCreateService.err),
+				}
+			}
+			handler := NewPriceHandler(mockPriceService).CreatePrice()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.Equal(t, tc.expLocation, res.Header().Get("Location"))
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestPriceHandler_GetPrice(t *testing.T) {
+	type args struct {
+		givenPriceID  string
+		expCall       bool
+		mockOutput    model.ProductPrice
+		mockErr       error
+		expStatusCode int
+		expResponse   string
+	}
+
+	price := model.ProductPrice{
+		ID:        2,
+		ProductID: 1,
+		Price:     model.Money{Amount: 899, Currency: "EUR"},
+		ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenPriceID:  "2",
+			expCall:       true,
+			mockOutput:    price,
+			expStatusCode: http.StatusOK,
+			expResponse:   ToJsonString(toPriceResponse(price)),
+		},
+		"err - invalid price id": {
+			givenPriceID:  "abc",
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Cannot convert id to integer",
+			}),
+		},
+		"err - not found": {
+			givenPriceID:  "2",
+			expCall:       true,
+			mockErr:       apperr.NotFound("price", sql.ErrNoRows),
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "Price not found",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products/1/prices/"+tc.givenPriceID, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			routeCtx.URLParams.Add("priceID", tc.givenPriceID)
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockPriceService := service.NewMockPriceService(t)
+
+			// When
+			if tc.expCall {
+				mockPriceService.ExpectedCalls = []*mock.Call{
+					mockPriceService.On("GetOne", ctx, int64(1), int64(2)).Return(tc.mockOutput, tc.mockErr),
+				}
+			}
+			handler := NewPriceHandler(mockPriceService).GetPrice()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestPriceHandler_DeletePrice(t *testing.T) {
+	type args struct {
+		givenPriceID  string
+		expCall       bool
+		mockErr       error
+		expStatusCode int
+		expResponse   string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenPriceID:  "2",
+			expCall:       true,
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusOK,
+				Description: "Price deleted",
+			}),
+		},
+		"err - invalid price id": {
+			givenPriceID:  "abc",
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Cannot convert id to integer",
+			}),
+		},
+		"err - not found": {
+			givenPriceID:  "2",
+			expCall:       true,
+			mockErr:       apperr.NotFound("price", sql.ErrNoRows),
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "Price not found",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodDelete, "/products/1/prices/"+tc.givenPriceID, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			routeCtx.URLParams.Add("priceID", tc.givenPriceID)
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockPriceService := service.NewMockPriceService(t)
+
+			// When
+			if tc.expCall {
+				mockPriceService.ExpectedCalls = []*mock.Call{
+					mockPriceService.On("Delete", ctx, int64(1), int64(2)).Return(tc.mockErr),
+				}
+			}
+			handler := NewPriceHandler(mockPriceService).DeletePrice()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestPriceHandler_SetExchangeRates(t *testing.T) {
+	type args struct {
+		givenRequest  string
+		expCall       bool
+		expStatusCode int
+		expResponse   string
+	}
+
+	updatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rates := model.ExchangeRates{
+		Base: "EUR",
+		Rates: map[string]decimal.Decimal{
+			"USD": decimal.RequireFromString("1.08"),
+			"JPY": decimal.RequireFromString("163.5"),
+		},
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest:  `{"base":"eur","rates":{"usd":"1.08","JPY":163.5}}`,
+			expCall:       true,
+			expStatusCode: http.StatusOK,
+			expResponse:   `{"base":"EUR","rates":{"JPY":"163.5","USD":"1.08"},"updated_at":"2024-01-01T00:00:00Z"}`,
+		},
+		"err - invalid rate": {
+			givenRequest:  `{"base":"EUR","rates":{"USD":"much"}}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid exchange rates",
+			}),
+		},
+		"err - no rates": {
+			givenRequest:  `{"base":"EUR","rates":{}}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Validation failed: rates is required",
+			}),
+		},
+		"err - validation": {
+			givenRequest:  `{"base":"EUR","rates":{"XXX":"1","EUR":"1","USD":"-1.08"}}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code: http.StatusBadRequest,
+				Description: "Validation failed: rates.EUR must not be the base currency, " +
+					"rates.USD must be positive, rates.XXX must be one of CHF, EUR, GBP, JPY, USD",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPut, "/exchange-rates", strings.NewReader(tc.givenRequest))
+			res := httptest.NewRecorder()
+
+			mockPriceService := service.NewMockPriceService(t)
+
+			// When
+			if tc.expCall {
+				stored := rates
+				stored.UpdatedAt = updatedAt
+				mockPriceService.ExpectedCalls = []*mock.Call{
+					mockPriceService.On("SetExchangeRates", req.Context(), mock.MatchedBy(func(given model.ExchangeRates) bool {
+						return given.Base == rates.Base && len(given.Rates) == len(rates.Rates) &&
+							given.Rates["USD"].Equal(rates.Rates["USD"]) && given.Rates["JPY"].Equal(rates.Rates["JPY"])
+					})).Return(stored, nil),
+				}
+			}
+			handler := NewPriceHandler(mockPriceService).SetExchangeRates()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestPriceHandler_SchedulePriceChange(t *testing.T) {
+	type mockScheduleService struct {
+		expCall bool
+		output  model.PriceChange
+		err     error
+	}
+
+	type args struct {
+		givenRequest        string
+		mockScheduleService mockScheduleService
+		expStatusCode       int
+		expLocation         string
+		expResponse         string
+	}
+
+	effectiveAt := time.Date(2030, 11, 29, 0, 0, 0, 0, time.UTC)
+	change := model.PriceChange{
+		ProductID: 1,
+		Price:     model.Money{Amount: 799, Currency: "USD"},
+		ChangeNote: model.ChangeNote{
+			ChangedBy: model.Actor{UserID: 1},
+			Reason:    "black friday",
+		},
+		EffectiveAt: effectiveAt,
+	}
+	scheduled := change
+	scheduled.ID = 2
+	scheduled.CreatedAt = time.Date(2030, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"price":{"amount":799,"currency":"usd"},"effective_at":"2030-11-29T00:00:00Z","reason":"black friday"}`,
+			mockScheduleService: mockScheduleService{
+				expCall: true,
+				output:  scheduled,
+			},
+			expStatusCode: http.StatusCreated,
+			expLocation:   "/products/1/price-changes/2",
+			expResponse:   ToJsonString(toPriceChangeResponse(scheduled)),
+		},
+		"err - validation": {
+			givenRequest:  `{"price":{"amount":799,"currency":"USD"}}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Validation failed: effective_at is required, reason is required",
+			}),
+		},
+		"err - in the past": {
+			givenRequest: `{"price":{"amount":799,"currency":"usd"},"effective_at":"2030-11-29T00:00:00Z","reason":"black friday"}`,
+			mockScheduleService: mockScheduleService{
+				expCall: true,
+				err:     apperr.Validation(apperr.FieldViolation{Field: "effective_at", Message: "must be in the future"}),
+			},
+			expStatusCode: http.StatusUnprocessableEntity,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnprocessableEntity,
+				Description: "Validation failed: effective_at must be in the future",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/products/1/price-changes", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			ctx = withRole(t, ctx, model.RoleEditor)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockPriceService := service.NewMockPriceService(t)
+
+			// When
+			if tc.mockScheduleService.expCall {
+				mockPriceService.ExpectedCalls = []*mock.Call{
+					mockPriceService.On("SchedulePriceChange", ctx, change).Return(tc.mockScheduleService.output, tc.mockScheduleService.err),
+				}
+			}
+			handler := NewPriceHandler(mockPriceService).SchedulePriceChange()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.Equal(t, tc.expLocation, res.Header().Get("Location"))
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestPriceHandler_GetPriceChange(t *testing.T) {
+	type args struct {
+		givenChangeID string
+		expCall       bool
+		mockOutput    model.PriceChange
+		mockErr       error
+		expStatusCode int
+		expResponse   string
+	}
+
+	change := model.PriceChange{
+		ID:          2,
+		ProductID:   1,
+		Price:       model.Money{Amount: 799, Currency: "USD"},
+		ChangeNote:  model.ChangeNote{ChangedBy: model.Actor{UserID: 1}, Reason: "black friday"},
+		EffectiveAt: time.Date(2030, 11, 29, 0, 0, 0, 0, time.UTC),
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenChangeID: "2",
+			expCall:       true,
+			mockOutput:    change,
+			expStatusCode: http.StatusOK,
+			expResponse: `{
+				"id": "2",
+				"product_id": "1",
+				"price": {"amount": 799, "currency": "USD", "display": "7.99 USD"},
+				"previous_price": null,
+				"status": "scheduled",
+				"reason": "black friday",
+				"changed_by": {"user_id": "1"},
+				"effective_at": "2030-11-29T00:00:00Z",
+				"applied_at": null,
+				"created_at": "0001-01-01T00:00:00Z"
+			}`,
+		},
+		"err - invalid change id": {
+			givenChangeID: "0",
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid id",
+			}),
+		},
+		"err - not found": {
+			givenChangeID: "2",
+			expCall:       true,
+			mockErr:       apperr.NotFound("price change", sql.ErrNoRows),
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "Price change not found",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products/1/price-changes/"+tc.givenChangeID, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			routeCtx.URLParams.Add("changeID", tc.givenChangeID)
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockPriceService := service.NewMockPriceService(t)
+
+			// When
+			if tc.expCall {
+				mockPriceService.ExpectedCalls = []*mock.Call{
+					mockPriceService.On("GetPriceChange", ctx, int64(1), int64(2)).Return(tc.mockOutput, tc.mockErr),
+				}
+			}
+			handler := NewPriceHandler(mockPriceService).GetPriceChange()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
+
+func TestPriceHandler_GetPriceHistory(t *testing.T) {
+	type args struct {
+		givenID       string
+		expCall       bool
+		mockOutput    []model.PriceChange
+		mockErr       error
+		expStatusCode int
+		expResponse   string
+	}
+
+	history := []model.PriceChange{
+		{
+			ID:          3,
+			ProductID:   1,
+			Price:       model.Money{Amount: 799, Currency: "USD"},
+			ChangeNote:  model.ChangeNote{ChangedBy: model.Actor{APIKeyID: 2}, Reason: "black friday"},
+			EffectiveAt: time.Date(2030, 11, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          2,
+			ProductID:   1,
+			Price:       model.Money{Amount: 999, Currency: "USD"},
+			Previous:    model.Money{Amount: 899, Currency: "USD"},
+			ChangeNote:  model.ChangeNote{ChangedBy: model.Actor{UserID: 1}},
+			EffectiveAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			AppliedAt:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID:       "1",
+			expCall:       true,
+			mockOutput:    history,
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.ListResponse{
+				Data:  toPriceChangeResponses(history),
+				Total: 2,
+			}),
+		},
+		"err - not found": {
+			givenID:       "1",
+			expCall:       true,
+			mockErr:       apperr.NotFound("product", sql.ErrNoRows),
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "Product not found",
+			}),
+		},
+		"err - invalid id": {
+			givenID:       "0",
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid id",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products/"+tc.givenID+"/price-history", nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tc.givenID)
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockPriceService := service.NewMockPriceService(t)
+
+			// When
+			if tc.expCall {
+				mockPriceService.ExpectedCalls = []*mock.Call{
+					mockPriceService.On("GetPriceHistory", ctx, int64(1)).Return(tc.mockOutput, tc.mockErr),
+				}
+			}
+			handler := NewPriceHandler(mockPriceService).GetPriceHistory()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"chi-demo/log"
+	"chi-demo/model"
+	"context"
+	"net/http"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+type principalCtxKey struct{}
+
+// NewPrincipalContext returns ctx carrying the authenticated principal. The
+// request logger is tagged with it too.
+func NewPrincipalContext(ctx context.Context, principal model.Principal) context.Context {
+	if principal.UserID != 0 {
+		log.AddFields(ctx, "user_id", principal.UserID)
+	}
+	if principal.APIKeyID != 0 {
+		log.AddFields(ctx, "api_key_id", principal.APIKeyID)
+	}
+	return context.WithValue(ctx, principalCtxKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal put in ctx by the
+// authentication middleware.
+func PrincipalFromContext(ctx context.Context) (model.Principal, bool) {
+	principal, ok := ctx.Value(principalCtxKey{}).(model.Principal)
+	return principal, ok
+}
+
+// TokenPrincipal turns the verified JWT into the request principal. It must
+// be placed after the Denylist.
+func TokenPrincipal(next http.Handler) http.Handler {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		token, _, err := jwtauth.FromContext(r.Context())
+		if err != nil || token == nil {
+			return HandlerErr{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid token",
+			}
+		}
+		userID, ok := userIDFromToken(token)
+		if !ok {
+			return HandlerErr{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid token",
+			}
+		}
+
+		role, _ := token.Get("role")
+		roleName, _ := role.(string)
+		principal := model.Principal{
+			UserID:         userID,
+			Role:           model.Role(roleName),
+			TokenID:        token.JwtID(),
+			TokenExpiresAt: token.Expiration(),
+			Scopes:         model.RoleScopes[model.Role(roleName)],
+		}
+
+		next.ServeHTTP(w, r.WithContext(NewPrincipalContext(r.Context(), principal)))
+		return nil
+	})
+}
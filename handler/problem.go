@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+// ProblemContentType is the media type of RFC 7807 error responses.
+const ProblemContentType = "application/problem+json"
+
+// problemTypes are the problem type URIs by status, relative to the API.
+var problemTypes = map[int]string{
+	http.StatusBadRequest:            "/problems/bad-request",
+	http.StatusUnauthorized:          "/problems/unauthorized",
+	http.StatusForbidden:             "/problems/forbidden",
+	http.StatusNotFound:              "/problems/not-found",
+	http.StatusConflict:              "/problems/conflict",
+	http.StatusPreconditionFailed:    "/problems/precondition-failed",
+	http.StatusUnprocessableEntity:   "/problems/validation-error",
+	http.StatusInternalServerError:   "/problems/internal-error",
+	http.StatusServiceUnavailable:    "/problems/unavailable",
+	http.StatusRequestEntityTooLarge: "/problems/payload-too-large",
+}
+
+// writeErr writes herr as a problem document when the client accepts one,
+// in the legacy Response shape otherwise.
+func writeErr(w http.ResponseWriter, r *http.Request, herr HandlerErr) {
+	w.Header().Add("Vary", "Accept")
+
+	if !acceptsProblem(r) {
+		w.WriteHeader(herr.Code)
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        herr.Code,
+			Description: herr.Description,
+		})
+		return
+	}
+
+	problemType, ok := problemTypes[herr.Code]
+	if !ok {
+		problemType = "about:blank"
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(herr.Code)
+	json.NewEncoder(w).Encode(model.Problem{
+		Type:     problemType,
+		Title:    http.StatusText(herr.Code),
+		Status:   herr.Code,
+		Detail:   herr.Description,
+		Instance: middleware.GetReqID(r.Context()),
+		Errors:   herr.Violations,
+	})
+}
+
+// acceptsProblem reports whether the Accept header explicitly asks for
+// problem details. Wildcards keep the legacy shape until clients migrate.
+func acceptsProblem(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil || mediaType != ProblemContentType {
+			continue
+		}
+		if q, ok := params["q"]; ok && strings.Trim(q, "0.") == "" {
+			continue
+		}
+		return true
+	}
+	return false
+}
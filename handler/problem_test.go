@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrHandler(t *testing.T) {
+	type args struct {
+		givenAccept    string
+		givenErr       error
+		expStatusCode  int
+		expContentType string
+		expResponse    string
+	}
+
+	tcs := map[string]args{
+		"legacy - no accept": {
+			givenErr: HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid id",
+			},
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid id",
+			}),
+		},
+		"legacy - wildcard": {
+			givenAccept:   "*/*",
+			givenErr:      apperr.NotFound("product", nil),
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "Product not found",
+			}),
+		},
+		"legacy - problem refused": {
+			givenAccept:   "application/json, application/problem+json;q=0",
+			givenErr:      apperr.NotFound("product", nil),
+			expStatusCode: http.StatusNotFound,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusNotFound,
+				Description: "Product not found",
+			}),
+		},
+		"problem - handler error": {
+			givenAccept: "application/problem+json",
+			givenErr: HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid id",
+			},
+			expStatusCode:  http.StatusBadRequest,
+			expContentType: ProblemContentType,
+			expResponse: ToJsonString(model.Problem{
+				Type:     "/problems/bad-request",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Detail:   "Invalid id",
+				Instance: "req-1",
+			}),
+		},
+		"problem - validation": {
+			givenAccept: "application/json, application/problem+json;q=0.9",
+			givenErr: apperr.Validation(
+				apperr.FieldViolation{Field: "name", Message: "must not be empty"},
+				apperr.FieldViolation{Field: "price", Message: "must be positive"},
+			),
+			expStatusCode:  http.StatusUnprocessableEntity,
+			expContentType: ProblemContentType,
+			expResponse: ToJsonString(model.Problem{
+				Type:     "/problems/validation-error",
+				Title:    "Unprocessable Entity",
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   "Validation failed: name must not be empty, price must be positive",
+				Instance: "req-1",
+				Errors: []apperr.FieldViolation{
+					{Field: "name", Message: "must not be empty"},
+					{Field: "price", Message: "must be positive"},
+				},
+			}),
+		},
+		"problem - handler violations": {
+			givenAccept: "application/problem+json",
+			givenErr: HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Missing field",
+				Violations:  []apperr.FieldViolation{{Field: "name", Message: "is required"}},
+			},
+			expStatusCode:  http.StatusBadRequest,
+			expContentType: ProblemContentType,
+			expResponse: ToJsonString(model.Problem{
+				Type:     "/problems/bad-request",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Detail:   "Missing field",
+				Instance: "req-1",
+				Errors:   []apperr.FieldViolation{{Field: "name", Message: "is required"}},
+			}),
+		},
+		"problem - internal error": {
+			givenAccept:    "application/problem+json",
+			givenErr:       errors.New("test"),
+			expStatusCode:  http.StatusInternalServerError,
+			expContentType: ProblemContentType,
+			expResponse: ToJsonString(model.Problem{
+				Type:     "/problems/internal-error",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Detail:   "Internal Server Error",
+				Instance: "req-1",
+			}),
+		},
+		"problem - unknown status": {
+			givenAccept: "application/problem+json",
+			givenErr: HandlerErr{
+				Code:        http.StatusTeapot,
+				Description: "Teapot",
+			},
+			expStatusCode:  http.StatusTeapot,
+			expContentType: ProblemContentType,
+			expResponse: ToJsonString(model.Problem{
+				Type:     "about:blank",
+				Title:    "I'm a teapot",
+				Status:   http.StatusTeapot,
+				Detail:   "Teapot",
+				Instance: "req-1",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products/1", nil)
+			if tc.givenAccept != "" {
+				req.Header.Set("Accept", tc.givenAccept)
+			}
+			req = req.WithContext(context.WithValue(req.Context(), middleware.RequestIDKey, "req-1"))
+			res := httptest.NewRecorder()
+
+			// When
+			handler := ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+				return tc.givenErr
+			})
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			require.Equal(t, "Accept", res.Header().Get("Vary"))
+			if tc.expContentType != "" {
+				require.Equal(t, tc.expContentType, res.Header().Get("Content-Type"))
+			}
+			require.JSONEq(t, tc.expResponse, res.Body.String())
+		})
+	}
+}
@@ -1,29 +1,40 @@
 package handler
 
 import (
+	"chi-demo/apperr"
+	"chi-demo/config"
 	"chi-demo/log"
 	"chi-demo/model"
 	"chi-demo/service"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
-
-	"github.com/go-chi/chi"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 type ProductHandler struct {
 	productService service.ProductService
+	priceService   service.PriceService
+	cfg            config.Config
 }
 
-func New(productService service.ProductService) ProductHandler {
+func New(productService service.ProductService, priceService service.PriceService, cfg config.Config) ProductHandler {
 	return ProductHandler{
 		productService: productService,
+		priceService:   priceService,
+		cfg:            cfg,
 	}
 }
 
 type HandlerErr struct {
 	Code        int
 	Description string
+	// Violations lists the offending fields, if any
+	Violations []apperr.FieldViolation
 }
 
 func (e HandlerErr) Error() string {
@@ -34,133 +45,254 @@ func ErrHandler(handlerFunc func(w http.ResponseWriter, r *http.Request) error)
 	return func(w http.ResponseWriter, r *http.Request) {
 		if err := handlerFunc(w, r); err != nil {
 			herr, ok := err.(HandlerErr)
+			if !ok {
+				herr, ok = fromDomainErr(err)
+			}
 			if ok {
-				w.WriteHeader(herr.Code)
-
-				json.NewEncoder(w).Encode(model.Response{
-					Code:        herr.Code,
-					Description: herr.Description,
-				})
+				writeErr(w, r, herr)
 
-				log.GetLogger().Printf("error %s\n", err.Error())
+				log.FromContext(r.Context()).Warn("request failed", "status", herr.Code, "error", err)
 
 				return
 			}
 
-			w.WriteHeader(http.StatusInternalServerError)
-
-			json.NewEncoder(w).Encode(model.Response{
+			writeErr(w, r, HandlerErr{
 				Code:        http.StatusInternalServerError,
 				Description: "Internal Server Error",
 			})
 
-			log.GetLogger().Printf("error %s\n", err.Error())
+			log.FromContext(r.Context()).Error("request failed", "status", http.StatusInternalServerError, "error", err)
 		}
 	}
 }
 
+// GetOne returns a product, with its price in the market currency given by
+// the currency query parameter as local_price. A currency without an
+// exchange rate from the product currency is a 422.
 func (productHandler ProductHandler) GetOne() http.HandlerFunc {
 	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
-		idParam := chi.URLParam(r, "id")
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
 
-		// convert id to int
-		id, err := strconv.ParseInt(idParam, 10, 64)
+		opts, err := readOptions(r)
 		if err != nil {
-			return HandlerErr{
-				Code:        http.StatusBadRequest,
-				Description: "Cannot convert id to integer",
-			}
+			return err
 		}
 
-		// check if id > 0
-		if id < 0 {
+		currency := normalizeCurrency(r.URL.Query().Get("currency"))
+		if currency != "" && !model.IsSupportedCurrency(currency) {
 			return HandlerErr{
 				Code:        http.StatusBadRequest,
-				Description: "Invalid id",
+				Description: "Invalid currency, must be one of " + strings.Join(model.SupportedCurrencies(), ", "),
 			}
 		}
 
-		product, err := productHandler.productService.GetOne(r.Context(), id)
+		product, err := productHandler.productService.GetOne(r.Context(), id, opts)
 		if err != nil {
 			return err
 		}
 
-		json.NewEncoder(w).Encode(product)
+		res := toProductResponse(product)
+		if currency != "" {
+			localPrice, err := productHandler.priceService.Localize(r.Context(), product, currency, time.Now())
+			if err != nil {
+				return err
+			}
+			res.LocalPrice = toLocalPriceResponse(localPrice)
+		}
+
+		json.NewEncoder(w).Encode(res)
 		return nil
 	})
 }
 
 func (productHandler ProductHandler) GetProducts() http.HandlerFunc {
 	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
-		products, err := productHandler.productService.GetAll(r.Context())
+		query, err := listQuery(r, productHandler.cfg.API)
+		if err != nil {
+			return err
+		}
+
+		page, err := productHandler.productService.GetAll(r.Context(), query)
 		if err != nil {
 			return err
 		}
-		json.NewEncoder(w).Encode(products)
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:       toProductResponses(page.Products),
+			Total:      page.Total,
+			NextCursor: encodeCursor(page.NextID),
+		})
 		return nil
 	})
 }
 
-func (productHandler ProductHandler) CreateProduct() http.HandlerFunc {
+// queries shorter than this fall back to trigram substring matching, or are
+// rejected when fuzzy search is disabled
+const minFullTextQueryLength = 3
+
+func (productHandler ProductHandler) SearchProducts() http.HandlerFunc {
 	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
-		var inputProduct model.Product
-		if err := json.NewDecoder(r.Body).Decode(&inputProduct); err != nil {
+		params := r.URL.Query()
+
+		text := strings.TrimSpace(params.Get("q"))
+		if text == "" {
 			return HandlerErr{
 				Code:        http.StatusBadRequest,
-				Description: "Invalid product",
+				Description: "Missing search query",
 			}
 		}
 
-		// check if fields exist
-		if inputProduct.Name == "" || inputProduct.Price == 0 {
+		query := model.SearchQuery{
+			Text:   text,
+			Prefix: true,
+			Fuzzy:  utf8.RuneCountInString(text) < minFullTextQueryLength,
+		}
+		if query.Fuzzy && !productHandler.cfg.Features.FuzzySearch {
 			return HandlerErr{
 				Code:        http.StatusBadRequest,
-				Description: "Missing field",
+				Description: fmt.Sprintf("Search query too short, must be at least %d characters", minFullTextQueryLength),
 			}
 		}
-
-		// check if price > 0
-		if inputProduct.Price < 0 {
-			return HandlerErr{
-				Code:        http.StatusBadRequest,
-				Description: "Invalid price",
+		if prefix := params.Get("prefix"); prefix != "" {
+			b, err := strconv.ParseBool(prefix)
+			if err != nil {
+				return HandlerErr{
+					Code:        http.StatusBadRequest,
+					Description: "Invalid prefix",
+				}
 			}
+			query.Prefix = b
 		}
 
-		err := productHandler.productService.Create(r.Context(), inputProduct)
+		page, err := pageParams(params, productHandler.cfg.API)
 		if err != nil {
-			// log.Printf("Error when create product: %s", err.Error())
 			return err
 		}
 
-		json.NewEncoder(w).Encode(model.Response{
-			Code:        http.StatusOK,
-			Description: "Product created",
+		result, err := productHandler.productService.Search(r.Context(), query, page)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.ListResponse{
+			Data:  toSearchResultResponses(result.Results),
+			Total: result.Total,
 		})
+		return nil
+	})
+}
 
+func (productHandler ProductHandler) CreateProduct() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input productRequest
+		if err := decodeJSON(r, &input, "Invalid product"); err != nil {
+			return err
+		}
+
+		inputProduct := input.toProduct()
+		if err := validateProduct(inputProduct); err != nil {
+			return err
+		}
+
+		product, err := productHandler.productService.Create(r.Context(), inputProduct)
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Location", fmt.Sprintf("/products/%d", product.ID))
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(toProductResponse(product))
 		return nil
 	})
 }
 
-func (productHandler ProductHandler) DeleteProduct() http.HandlerFunc {
+// UpdateProduct replaces a product. A price change is recorded in the price
+// history, with the X-Change-Reason header as its reason.
+func (productHandler ProductHandler) UpdateProduct() http.HandlerFunc {
 	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
-		idParam := chi.URLParam(r, "id")
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		var input productRequest
+		if err := decodeJSON(r, &input, "Invalid product"); err != nil {
+			return err
+		}
+
+		inputProduct := input.toProduct()
+		inputProduct.ID = id
+		if err := validateProduct(inputProduct); err != nil {
+			return err
+		}
 
-		// convert id to int
-		id, err := strconv.ParseInt(idParam, 10, 64)
+		note, err := changeNote(r)
 		if err != nil {
-			return HandlerErr{
-				Code:        http.StatusBadRequest,
-				Description: "Cannot convert id to integer",
-			}
+			return err
 		}
 
-		// check if id > 0
-		if id < 0 {
-			return HandlerErr{
-				Code:        http.StatusBadRequest,
-				Description: "Invalid id",
-			}
+		product, err := productHandler.productService.Update(r.Context(), inputProduct, note)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toProductResponse(product))
+		return nil
+	})
+}
+
+// PatchProduct applies a JSON Merge Patch (RFC 7396) to an existing product.
+// Absent members are left untouched; null members are removed, which for the
+// required name and price fields fails validation. Price changes are recorded
+// like in UpdateProduct.
+func (productHandler ProductHandler) PatchProduct() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		var patch productPatch
+		if err := decodeJSON(r, &patch, "Invalid product"); err != nil {
+			return err
+		}
+
+		note, err := changeNote(r)
+		if err != nil {
+			return err
+		}
+
+		product, err := productHandler.productService.GetOne(r.Context(), id, model.ReadOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := applyProductPatch(&product, patch); err != nil {
+			return err
+		}
+
+		if err := validateProduct(product); err != nil {
+			return err
+		}
+
+		product, err = productHandler.productService.Update(r.Context(), product, note)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toProductResponse(product))
+		return nil
+	})
+}
+
+func (productHandler ProductHandler) DeleteProduct() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
 		}
 
 		err = productHandler.productService.Delete(r.Context(), id)
@@ -175,3 +307,158 @@ func (productHandler ProductHandler) DeleteProduct() http.HandlerFunc {
 		return nil
 	})
 }
+
+func (productHandler ProductHandler) RestoreProduct() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return err
+		}
+
+		product, err := productHandler.productService.Restore(r.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(toProductResponse(product))
+		return nil
+	})
+}
+
+// PurgeProducts hard-deletes products tombstoned for longer than the
+// retention window given as a Go duration (e.g. "720h") in the retention
+// query parameter, defaulting to service.DefaultPurgeRetention.
+func (productHandler ProductHandler) PurgeProducts() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		retention := service.DefaultPurgeRetention
+		if retentionParam := r.URL.Query().Get("retention"); retentionParam != "" {
+			d, err := time.ParseDuration(retentionParam)
+			if err != nil || d < 0 {
+				return HandlerErr{
+					Code:        http.StatusBadRequest,
+					Description: "Invalid retention",
+				}
+			}
+			retention = d
+		}
+
+		purged, err := productHandler.productService.Purge(r.Context(), retention)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        http.StatusOK,
+			Description: fmt.Sprintf("%d products purged", purged),
+		})
+		return nil
+	})
+}
+
+func readOptions(r *http.Request) (model.ReadOptions, error) {
+	var opts model.ReadOptions
+
+	if includeDeleted := r.URL.Query().Get("include_deleted"); includeDeleted != "" {
+		b, err := strconv.ParseBool(includeDeleted)
+		if err != nil {
+			return model.ReadOptions{}, HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid include_deleted",
+			}
+		}
+		// tombstoned products are only visible to admins
+		if b && roleFromContext(r.Context()) != model.RoleAdmin {
+			return model.ReadOptions{}, HandlerErr{
+				Code:        http.StatusForbidden,
+				Description: "Forbidden",
+			}
+		}
+		opts.IncludeDeleted = b
+	}
+
+	return opts, nil
+}
+
+func pageParams(params url.Values, cfg config.APIConfig) (model.Page, error) {
+	page := model.Page{Limit: cfg.DefaultPageLimit}
+
+	var err error
+	if limit := params.Get("limit"); limit != "" {
+		page.Limit, err = strconv.Atoi(limit)
+		if err != nil || page.Limit < 1 || page.Limit > cfg.MaxPageLimit {
+			return model.Page{}, HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: fmt.Sprintf("Invalid limit, must be between 1 and %d", cfg.MaxPageLimit),
+			}
+		}
+	}
+
+	if offset := params.Get("offset"); offset != "" {
+		page.Offset, err = strconv.Atoi(offset)
+		if err != nil || page.Offset < 0 {
+			return model.Page{}, HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid offset",
+			}
+		}
+	}
+
+	return page, nil
+}
+
+func listQuery(r *http.Request, cfg config.APIConfig) (model.ProductListQuery, error) {
+	opts, err := readOptions(r)
+	if err != nil {
+		return model.ProductListQuery{}, err
+	}
+
+	params := r.URL.Query()
+
+	page, err := pageParams(params, cfg)
+	if err != nil {
+		return model.ProductListQuery{}, err
+	}
+
+	query := model.ProductListQuery{
+		ReadOptions: opts,
+		Limit:       page.Limit,
+		Offset:      page.Offset,
+	}
+
+	query.Filters, err = parseFilters(params)
+	if err != nil {
+		return model.ProductListQuery{}, err
+	}
+
+	query.Sort, err = parseSort(params.Get("sort"))
+	if err != nil {
+		return model.ProductListQuery{}, err
+	}
+
+	if cursor := params.Get("cursor"); cursor != "" {
+		if query.Offset > 0 {
+			return model.ProductListQuery{}, HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Cannot combine cursor and offset",
+			}
+		}
+
+		// cursors follow the id order
+		if len(query.Sort) > 0 {
+			return model.ProductListQuery{}, HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Cannot combine cursor and sort",
+			}
+		}
+
+		query.AfterID, err = decodeCursor(cursor)
+		if err != nil {
+			return model.ProductListQuery{}, HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid cursor",
+			}
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/validate"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const (
+	maxProductNameLength = 255
+	// amounts stay exact as JavaScript numbers
+	maxPriceAmount = 1<<53 - 1
+)
+
+// moneyRequest is an amount in the minor units of currency, 1250 USD is
+// $12.50.
+type moneyRequest struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// productRequest is the payload creating or replacing a product.
+type productRequest struct {
+	Name  string       `json:"name"`
+	Price moneyRequest `json:"price"`
+}
+
+func (req productRequest) toProduct() model.Product {
+	return model.Product{
+		Name: strings.TrimSpace(req.Name),
+		Price: model.Money{
+			Amount:   req.Price.Amount,
+			Currency: normalizeCurrency(req.Price.Currency),
+		},
+	}
+}
+
+func normalizeCurrency(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// validateProduct reports every invalid field of product at once.
+func validateProduct(product model.Product) error {
+	var v validate.Validator
+	v.Field("name",
+		validate.Required(product.Name),
+		validate.MaxLength(product.Name, maxProductNameLength))
+	validateMoney(&v, "price", product.Price)
+	return invalidPayload(v.Err())
+}
+
+// validateMoney checks the amount and currency members of the money field.
+func validateMoney(v *validate.Validator, field string, money model.Money) {
+	v.Field(field+".amount",
+		validate.Required(money.Amount),
+		validate.Min(money.Amount, 1),
+		validate.Max(money.Amount, maxPriceAmount))
+	v.Field(field+".currency",
+		validate.Required(money.Currency),
+		validate.OneOf(money.Currency, model.SupportedCurrencies()...))
+}
+
+// productPatch is a JSON Merge Patch of a product. A member is nil when
+// absent and holds null when removed.
+type productPatch struct {
+	Name  json.RawMessage `json:"name"`
+	Price json.RawMessage `json:"price"`
+}
+
+// moneyPatch is the price member of a productPatch, merged member by member.
+type moneyPatch struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency json.RawMessage `json:"currency"`
+}
+
+// applyProductPatch merges patch into product, a null member is zeroed.
+// Every invalid member is reported at once.
+func applyProductPatch(product *model.Product, patch productPatch) error {
+	var violations []apperr.FieldViolation
+	invalidType := func(field string) {
+		violations = append(violations, apperr.FieldViolation{Field: field, Message: "has an invalid type"})
+	}
+
+	if err := mergeMember(patch.Name, &product.Name); err != nil {
+		invalidType("name")
+	}
+	product.Name = strings.TrimSpace(product.Name)
+
+	var price moneyPatch
+	switch {
+	case patch.Price == nil:
+	case string(patch.Price) == "null":
+		product.Price = model.Money{}
+	case json.Unmarshal(patch.Price, &price) != nil:
+		invalidType("price")
+	default:
+		for _, unknown := range unknownMembers(patch.Price, &price) {
+			unknown.Field = "price." + unknown.Field
+			violations = append(violations, unknown)
+		}
+		if err := mergeMember(price.Amount, &product.Price.Amount); err != nil {
+			invalidType("price.amount")
+		}
+		if err := mergeMember(price.Currency, &product.Price.Currency); err != nil {
+			invalidType("price.currency")
+		}
+		product.Price.Currency = normalizeCurrency(product.Price.Currency)
+	}
+
+	if len(violations) > 0 {
+		return HandlerErr{
+			Code:        http.StatusBadRequest,
+			Description: "Invalid product",
+			Violations:  violations,
+		}
+	}
+	return nil
+}
+
+// mergeMember merges a merge patch member into target: absent leaves it
+// untouched and null zeroes it.
+func mergeMember[T any](value json.RawMessage, target *T) error {
+	if value == nil {
+		return nil
+	}
+	// unmarshaling null leaves the target untouched, hence the reset
+	var zero T
+	*target = zero
+	return json.Unmarshal(value, target)
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"time"
+)
+
+// moneyResponse is an amount in the minor units of currency, with Display
+// the amount in major units for display, e.g. "12.50 USD".
+type moneyResponse struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Display  string `json:"display"`
+}
+
+func toMoneyResponse(money model.Money) moneyResponse {
+	return moneyResponse{
+		Amount:   money.Amount,
+		Currency: money.Currency,
+		Display:  money.String(),
+	}
+}
+
+// productResponse is the wire format of a product. The id is a string since
+// sonyflake ids exceed the integers JavaScript represents exactly.
+type productResponse struct {
+	ID        int64         `json:"id,string"`
+	Name      string        `json:"name"`
+	Price     moneyResponse `json:"price"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	DeletedAt *time.Time    `json:"deleted_at"`
+	// LocalPrice is only set when a currency was requested
+	LocalPrice *localPriceResponse `json:"local_price,omitempty"`
+}
+
+func toProductResponse(product model.Product) productResponse {
+	res := productResponse{
+		ID:        product.ID,
+		Name:      product.Name,
+		Price:     toMoneyResponse(product.Price),
+		CreatedAt: product.CreatedAt,
+		UpdatedAt: product.UpdatedAt,
+	}
+	// live products have a zero DeletedAt, sent as null
+	if !product.DeletedAt.IsZero() {
+		res.DeletedAt = &product.DeletedAt
+	}
+	return res
+}
+
+func toProductResponses(products []model.Product) []productResponse {
+	res := make([]productResponse, 0, len(products))
+	for _, product := range products {
+		res = append(res, toProductResponse(product))
+	}
+	return res
+}
+
+// searchResultResponse is the wire format of a search match.
+type searchResultResponse struct {
+	Product productResponse `json:"product"`
+	Rank    float64         `json:"rank"`
+	Snippet string          `json:"snippet"`
+}
+
+func toSearchResultResponses(results []model.SearchResult) []searchResultResponse {
+	res := make([]searchResultResponse, 0, len(results))
+	for _, result := range results {
+		res = append(res, searchResultResponse{
+			Product: toProductResponse(result.Product),
+			Rank:    result.Rank,
+			Snippet: result.Snippet,
+		})
+	}
+	return res
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Listing query language:
+//
+//	price[gte]=100&price[lt]=500&currency=EUR&name[ilike]=shoe&created_at[gt]=2023-01-02T15:04:05Z
+//	sort=-price,name
+//
+// A bare field=value means field[eq]=value. A leading "-" in sort means
+// descending. Prices are compared in minor units whatever their currency.
+
+type filterKind int
+
+const (
+	filterKindInt64 filterKind = iota
+	filterKindString
+	filterKindCurrency
+	filterKindTime
+)
+
+var filterFields = map[string]filterKind{
+	models.ProductColumns.ID:        filterKindInt64,
+	models.ProductColumns.Name:      filterKindString,
+	models.ProductColumns.Price:     filterKindInt64,
+	models.ProductColumns.Currency:  filterKindCurrency,
+	models.ProductColumns.CreatedAt: filterKindTime,
+	models.ProductColumns.UpdatedAt: filterKindTime,
+}
+
+var filterOperators = map[filterKind][]model.FilterOperator{
+	filterKindInt64:    {model.FilterEQ, model.FilterNEQ, model.FilterLT, model.FilterLTE, model.FilterGT, model.FilterGTE},
+	filterKindString:   {model.FilterEQ, model.FilterNEQ, model.FilterLike, model.FilterILike},
+	filterKindCurrency: {model.FilterEQ, model.FilterNEQ},
+	filterKindTime:     {model.FilterEQ, model.FilterNEQ, model.FilterLT, model.FilterLTE, model.FilterGT, model.FilterGTE},
+}
+
+var sortFields = map[string]bool{
+	models.ProductColumns.ID:        true,
+	models.ProductColumns.Name:      true,
+	models.ProductColumns.Price:     true,
+	models.ProductColumns.CreatedAt: true,
+	models.ProductColumns.UpdatedAt: true,
+}
+
+// query parameters that are not filters
+var reservedParams = map[string]bool{
+	"limit":           true,
+	"offset":          true,
+	"cursor":          true,
+	"include_deleted": true,
+	"sort":            true,
+}
+
+var filterParamRegex = regexp.MustCompile(`^([a-z_]+)(?:\[([a-z]+)\])?$`)
+
+func parseFilters(params url.Values) ([]model.ProductFilter, error) {
+	// sort keys so that filters and errors are deterministic
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if !reservedParams[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var filters []model.ProductFilter
+	for _, key := range keys {
+		match := filterParamRegex.FindStringSubmatch(key)
+		if match == nil {
+			return nil, badFilter("Invalid filter %s", key)
+		}
+
+		field, op := match[1], model.FilterOperator(match[2])
+		if op == "" {
+			op = model.FilterEQ
+		}
+
+		kind, ok := filterFields[field]
+		if !ok {
+			return nil, badFilter("Unknown filter field %s", field)
+		}
+		if !hasOperator(filterOperators[kind], op) {
+			return nil, badFilter("Unsupported operator %s for field %s", op, field)
+		}
+
+		for _, raw := range params[key] {
+			value, err := parseFilterValue(kind, raw)
+			if err != nil {
+				return nil, badFilter("Invalid value for filter %s", key)
+			}
+
+			filters = append(filters, model.ProductFilter{
+				Field:    field,
+				Operator: op,
+				Value:    value,
+			})
+		}
+	}
+
+	return filters, nil
+}
+
+func parseSort(param string) ([]model.ProductSort, error) {
+	if param == "" {
+		return nil, nil
+	}
+
+	var result []model.ProductSort
+	for _, field := range strings.Split(param, ",") {
+		s := model.ProductSort{Field: field}
+		if strings.HasPrefix(field, "-") {
+			s.Field, s.Desc = field[1:], true
+		}
+
+		if !sortFields[s.Field] {
+			return nil, badFilter("Unknown sort field %s", s.Field)
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+func parseFilterValue(kind filterKind, raw string) (interface{}, error) {
+	switch kind {
+	case filterKindInt64:
+		return strconv.ParseInt(raw, 10, 64)
+	case filterKindCurrency:
+		code := strings.ToUpper(raw)
+		if !model.IsSupportedCurrency(code) {
+			return nil, fmt.Errorf("unsupported currency %q", raw)
+		}
+		return code, nil
+	case filterKindTime:
+		return time.Parse(time.RFC3339, raw)
+	default:
+		return raw, nil
+	}
+}
+
+func hasOperator(ops []model.FilterOperator, op model.FilterOperator) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+func badFilter(format string, args ...interface{}) error {
+	return HandlerErr{
+		Code:        http.StatusBadRequest,
+		Description: fmt.Sprintf(format, args...),
+	}
+}
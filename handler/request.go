@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"bytes"
+	"chi-demo/apperr"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+)
+
+// decodeJSON decodes the single JSON value of the request body into dst and
+// rejects the members dst has no field for. invalid describes any other
+// malformed body.
+func decodeJSON(r *http.Request, dst interface{}, invalid string) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return decodeErr(err, invalid)
+	}
+
+	if violations := unknownMembers(body, dst); len(violations) > 0 {
+		return HandlerErr{
+			Code:        http.StatusBadRequest,
+			Description: invalid,
+			Violations:  violations,
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(dst); err != nil {
+		return decodeErr(err, invalid)
+	}
+	// anything after the value is as malformed as a broken value
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		return decodeErr(errors.New("unexpected data after the JSON value"), invalid)
+	}
+
+	return nil
+}
+
+func decodeErr(err error, invalid string) error {
+	var (
+		maxBytesErr *http.MaxBytesError
+		typeErr     *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return HandlerErr{
+			Code:        http.StatusRequestEntityTooLarge,
+			Description: fmt.Sprintf("Request body exceeds %d bytes", maxBytesErr.Limit),
+		}
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		return HandlerErr{
+			Code:        http.StatusBadRequest,
+			Description: invalid,
+			Violations:  []apperr.FieldViolation{{Field: typeErr.Field, Message: "has an invalid type"}},
+		}
+	}
+
+	// encoding/json has no error type for unknown fields
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		if unquoted, err := strconv.Unquote(field); err == nil {
+			field = unquoted
+		}
+		return HandlerErr{
+			Code:        http.StatusBadRequest,
+			Description: invalid,
+			Violations:  []apperr.FieldViolation{{Field: field, Message: "is not allowed"}},
+		}
+	}
+
+	return HandlerErr{
+		Code:        http.StatusBadRequest,
+		Description: invalid,
+	}
+}
+
+// unknownMembers reports the members of the JSON object body that match no
+// json tag of the struct dst points to. encoding/json matches names case
+// insensitively, so "Name" would otherwise be taken for "name".
+func unknownMembers(body []byte, dst interface{}) []apperr.FieldViolation {
+	t := reflect.TypeOf(dst)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		// not an object, left to the decoding to report
+		return nil
+	}
+
+	known := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch {
+		case !field.IsExported() || name == "-":
+			continue
+		case name == "":
+			name = field.Name
+		}
+		known[name] = true
+	}
+
+	var violations []apperr.FieldViolation
+	for member := range members {
+		if !known[member] {
+			violations = append(violations, apperr.FieldViolation{Field: member, Message: "is not allowed"})
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Field < violations[j].Field
+	})
+	return violations
+}
+
+// pathID parses the positive id in the URL parameter name.
+func pathID(r *http.Request, name string) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, name), 10, 64)
+	if err != nil {
+		return 0, HandlerErr{
+			Code:        http.StatusBadRequest,
+			Description: "Cannot convert id to integer",
+		}
+	}
+
+	if id <= 0 {
+		return 0, HandlerErr{
+			Code:        http.StatusBadRequest,
+			Description: "Invalid id",
+		}
+	}
+
+	return id, nil
+}
+
+// invalidPayload reports the violations of a request payload as a 400 like
+// the payloads that fail to decode, 422 is left to the rules the services
+// check.
+func invalidPayload(err error) error {
+	var validation *apperr.ValidationError
+	if !errors.As(err, &validation) {
+		return err
+	}
+	return HandlerErr{
+		Code:        http.StatusBadRequest,
+		Description: capitalize(validation.Error()),
+		Violations:  validation.Violations,
+	}
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"chi-demo/apperr"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	type args struct {
+		givenRequest string
+		expProduct   productRequest
+		expErr       error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"name":"test","price":{"amount":1,"currency":"USD"}}`,
+			expProduct:   productRequest{Name: "test", Price: moneyRequest{Amount: 1, Currency: "USD"}},
+		},
+		"err - unknown field": {
+			givenRequest: `{"name":"test","color":"red"}`,
+			expErr: HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid product",
+				Violations:  []apperr.FieldViolation{{Field: "color", Message: "is not allowed"}},
+			},
+		},
+		"err - names are case sensitive": {
+			givenRequest: `{"Name":"test","PRICE":1}`,
+			expErr: HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid product",
+				Violations: []apperr.FieldViolation{
+					{Field: "Name", Message: "is not allowed"},
+					{Field: "PRICE", Message: "is not allowed"},
+				},
+			},
+		},
+		"err - invalid type": {
+			givenRequest: `{"name":"test","price":"1"}`,
+			expErr: HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid product",
+				Violations:  []apperr.FieldViolation{{Field: "price", Message: "has an invalid type"}},
+			},
+		},
+		"err - malformed": {
+			givenRequest: `{"name":`,
+			expErr: HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid product",
+			},
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(tc.givenRequest))
+
+			// When
+			var product productRequest
+			err := decodeJSON(req, &product, "Invalid product")
+
+			// Then
+			if tc.expErr != nil {
+				require.Equal(t, tc.expErr, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expProduct, product)
+			}
+		})
+	}
+}
+
+func TestPathID(t *testing.T) {
+	type args struct {
+		givenID string
+		expID   int64
+		expErr  string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID: "1",
+			expID:   1,
+		},
+		"err - not an integer": {
+			givenID: "abc",
+			expErr:  "Cannot convert id to integer",
+		},
+		"err - zero": {
+			givenID: "0",
+			expErr:  "Invalid id",
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodGet, "/products/"+tc.givenID, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tc.givenID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+
+			// When
+			id, err := pathID(req, "id")
+
+			// Then
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expID, id)
+			}
+		})
+	}
+}
@@ -1,5 +1,180 @@
 package handler
 
+import (
+	"chi-demo/model"
+	"chi-demo/service"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	maxUsernameLength = 64
+	minPasswordLength = 8
+	// bcrypt ignores anything past 72 bytes
+	maxPasswordLength = 72
+)
+
 type UserHandler struct {
-	// TODO: add user service here
+	userService service.UserService
+}
+
+func NewUserHandler(userService service.UserService) UserHandler {
+	return UserHandler{
+		userService: userService,
+	}
+}
+
+type credentials struct {
+	Username string
+	Password string
+}
+
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (userHandler UserHandler) Register() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input credentials
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid user",
+			}
+		}
+
+		input.Username = strings.TrimSpace(input.Username)
+		if input.Username == "" || input.Password == "" {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Missing field",
+			}
+		}
+
+		if len(input.Username) > maxUsernameLength {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid username",
+			}
+		}
+
+		if len(input.Password) < minPasswordLength || len(input.Password) > maxPasswordLength {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid password",
+			}
+		}
+
+		_, err := userHandler.userService.Register(r.Context(), model.User{
+			Username: input.Username,
+			Password: input.Password,
+		})
+		if err != nil {
+			return err
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        http.StatusCreated,
+			Description: "User registered",
+		})
+		return nil
+	})
+}
+
+func (userHandler UserHandler) Login() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input credentials
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid credentials",
+			}
+		}
+
+		if input.Username == "" || input.Password == "" {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Missing field",
+			}
+		}
+
+		token, err := userHandler.userService.Login(r.Context(), strings.TrimSpace(input.Username), input.Password)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(token)
+		return nil
+	})
+}
+
+func (userHandler UserHandler) Refresh() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input refreshRequest
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid refresh request",
+			}
+		}
+
+		if input.RefreshToken == "" {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Missing field",
+			}
+		}
+
+		token, err := userHandler.userService.Refresh(r.Context(), input.RefreshToken)
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(token)
+		return nil
+	})
+}
+
+// Logout revokes the access token of the request. The body is optional and
+// may carry a refresh token whose family is revoked as well.
+func (userHandler UserHandler) Logout() http.HandlerFunc {
+	return ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		var input refreshRequest
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil && !errors.Is(err, io.EOF) {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid logout request",
+			}
+		}
+
+		// API keys have no token to revoke
+		principal, _ := PrincipalFromContext(r.Context())
+		if principal.UserID == 0 || principal.TokenID == "" {
+			return HandlerErr{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid token",
+			}
+		}
+
+		err := userHandler.userService.Logout(r.Context(), principal.UserID, principal.TokenID, principal.TokenExpiresAt, input.RefreshToken)
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			return HandlerErr{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid refresh token",
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		json.NewEncoder(w).Encode(model.Response{
+			Code:        http.StatusOK,
+			Description: "Logged out",
+		})
+		return nil
+	})
 }
@@ -0,0 +1,472 @@
+package handler
+
+import (
+	"chi-demo/model"
+	"chi-demo/repository"
+	"chi-demo/service"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Register(t *testing.T) {
+	type mockRegisterService struct {
+		expCall bool
+		err     error
+	}
+
+	type args struct {
+		givenRequest        string
+		mockRegisterService mockRegisterService
+		expStatusCode       int
+		expResponse         string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"username":" test ","password":"password"}`,
+			mockRegisterService: mockRegisterService{
+				expCall: true,
+			},
+			expStatusCode: http.StatusCreated,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusCreated,
+				Description: "User registered",
+			}),
+		},
+		"err - invalid user": {
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid user",
+			}),
+		},
+		"err - missing field": {
+			givenRequest:  `{"username":"test"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Missing field",
+			}),
+		},
+		"err - username too long": {
+			givenRequest:  `{"username":"` + strings.Repeat("a", 65) + `","password":"password"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid username",
+			}),
+		},
+		"err - password too short": {
+			givenRequest:  `{"username":"test","password":"pass"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid password",
+			}),
+		},
+		"err - username taken": {
+			givenRequest: `{"username":"test","password":"password"}`,
+			mockRegisterService: mockRegisterService{
+				expCall: true,
+				err:     repository.ErrUsernameTaken,
+			},
+			expStatusCode: http.StatusConflict,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusConflict,
+				Description: "Username already taken",
+			}),
+		},
+		"service error": {
+			givenRequest: `{"username":"test","password":"password"}`,
+			mockRegisterService: mockRegisterService{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			req.Header.Set("Content-Type", "application/json")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockUserService := service.NewMockUserService(t)
+
+			// When
+			if tc.mockRegisterService.expCall {
+				user := model.User{
+					Username: "test",
+					Password: "password",
+				}
+
+				mockUserService.ExpectedCalls = []*mock.Call{
+					mockUserService.On("Register", ctx, user).Return(model.User{}, tc.mockRegisterService.err),
+				}
+			}
+			instance := NewUserHandler(mockUserService)
+			handler := instance.Register()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Login(t *testing.T) {
+	type mockLoginService struct {
+		expCall bool
+		output  model.AuthToken
+		err     error
+	}
+
+	type args struct {
+		givenRequest     string
+		mockLoginService mockLoginService
+		expStatusCode    int
+		expResponse      string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"username":"test","password":"password"}`,
+			mockLoginService: mockLoginService{
+				expCall: true,
+				output: model.AuthToken{
+					AccessToken:  "token",
+					TokenType:    "Bearer",
+					ExpiresIn:    900,
+					RefreshToken: "refresh",
+				},
+			},
+			expStatusCode: http.StatusOK,
+			expResponse:   `{"access_token":"token","token_type":"Bearer","expires_in":900,"refresh_token":"refresh"}`,
+		},
+		"err - invalid credentials body": {
+			givenRequest:  `[]`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid credentials",
+			}),
+		},
+		"err - missing field": {
+			givenRequest:  `{"username":"test"}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Missing field",
+			}),
+		},
+		"err - wrong credentials": {
+			givenRequest: `{"username":"test","password":"password"}`,
+			mockLoginService: mockLoginService{
+				expCall: true,
+				err:     service.ErrInvalidCredentials,
+			},
+			expStatusCode: http.StatusUnauthorized,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid username or password",
+			}),
+		},
+		"service error": {
+			givenRequest: `{"username":"test","password":"password"}`,
+			mockLoginService: mockLoginService{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			req.Header.Set("Content-Type", "application/json")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockUserService := service.NewMockUserService(t)
+
+			// When
+			if tc.mockLoginService.expCall {
+				mockUserService.ExpectedCalls = []*mock.Call{
+					mockUserService.On("Login", ctx, "test", "password").Return(tc.mockLoginService.output, tc.mockLoginService.err),
+				}
+			}
+			instance := NewUserHandler(mockUserService)
+			handler := instance.Login()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Refresh(t *testing.T) {
+	type mockRefreshService struct {
+		expCall bool
+		output  model.AuthToken
+		err     error
+	}
+
+	type args struct {
+		givenRequest       string
+		mockRefreshService mockRefreshService
+		expStatusCode      int
+		expResponse        string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenRequest: `{"refresh_token":"refresh"}`,
+			mockRefreshService: mockRefreshService{
+				expCall: true,
+				output: model.AuthToken{
+					AccessToken:  "token",
+					TokenType:    "Bearer",
+					ExpiresIn:    900,
+					RefreshToken: "rotated",
+				},
+			},
+			expStatusCode: http.StatusOK,
+			expResponse:   `{"access_token":"token","token_type":"Bearer","expires_in":900,"refresh_token":"rotated"}`,
+		},
+		"err - invalid body": {
+			givenRequest:  `[]`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid refresh request",
+			}),
+		},
+		"err - missing field": {
+			givenRequest:  `{}`,
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Missing field",
+			}),
+		},
+		"err - invalid refresh token": {
+			givenRequest: `{"refresh_token":"refresh"}`,
+			mockRefreshService: mockRefreshService{
+				expCall: true,
+				err:     service.ErrInvalidRefreshToken,
+			},
+			expStatusCode: http.StatusUnauthorized,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid refresh token",
+			}),
+		},
+		"service error": {
+			givenRequest: `{"refresh_token":"refresh"}`,
+			mockRefreshService: mockRefreshService{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			req.Header.Set("Content-Type", "application/json")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockUserService := service.NewMockUserService(t)
+
+			// When
+			if tc.mockRefreshService.expCall {
+				mockUserService.ExpectedCalls = []*mock.Call{
+					mockUserService.On("Refresh", ctx, "refresh").Return(tc.mockRefreshService.output, tc.mockRefreshService.err),
+				}
+			}
+			instance := NewUserHandler(mockUserService)
+			handler := instance.Refresh()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Logout(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute)
+
+	type mockLogoutService struct {
+		expCall           bool
+		givenRefreshToken string
+		err               error
+	}
+
+	type args struct {
+		givenRequest      string
+		givenPrincipal    model.Principal
+		mockLogoutService mockLogoutService
+		expStatusCode     int
+		expResponse       string
+	}
+
+	principal := model.Principal{
+		UserID:         1,
+		Role:           model.RoleViewer,
+		TokenID:        "jti",
+		TokenExpiresAt: expiresAt,
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenPrincipal: principal,
+			mockLogoutService: mockLogoutService{
+				expCall: true,
+			},
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusOK,
+				Description: "Logged out",
+			}),
+		},
+		"success with refresh token": {
+			givenRequest:   `{"refresh_token":"refresh"}`,
+			givenPrincipal: principal,
+			mockLogoutService: mockLogoutService{
+				expCall:           true,
+				givenRefreshToken: "refresh",
+			},
+			expStatusCode: http.StatusOK,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusOK,
+				Description: "Logged out",
+			}),
+		},
+		"err - invalid body": {
+			givenRequest:   `[]`,
+			givenPrincipal: principal,
+			expStatusCode:  http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid logout request",
+			}),
+		},
+		"err - api key": {
+			givenPrincipal: model.Principal{
+				APIKeyID: 1,
+			},
+			expStatusCode: http.StatusUnauthorized,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusUnauthorized,
+				Description: "Invalid token",
+			}),
+		},
+		"err - invalid refresh token": {
+			givenRequest:   `{"refresh_token":"refresh"}`,
+			givenPrincipal: principal,
+			mockLogoutService: mockLogoutService{
+				expCall:           true,
+				givenRefreshToken: "refresh",
+				err:               service.ErrInvalidRefreshToken,
+			},
+			expStatusCode: http.StatusBadRequest,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusBadRequest,
+				Description: "Invalid refresh token",
+			}),
+		},
+		"service error": {
+			givenPrincipal: principal,
+			mockLogoutService: mockLogoutService{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expStatusCode: http.StatusInternalServerError,
+			expResponse: ToJsonString(model.Response{
+				Code:        http.StatusInternalServerError,
+				Description: "Internal Server Error",
+			}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", strings.NewReader(tc.givenRequest))
+			routeCtx := chi.NewRouteContext()
+			req.Header.Set("Content-Type", "application/json")
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx)
+			ctx = NewPrincipalContext(ctx, tc.givenPrincipal)
+			res := httptest.NewRecorder()
+
+			req = req.WithContext(ctx)
+
+			mockUserService := service.NewMockUserService(t)
+
+			// When
+			if tc.mockLogoutService.expCall {
+				mockUserService.ExpectedCalls = []*mock.Call{
+					mockUserService.On("Logout", ctx, int64(1), "jti", expiresAt, tc.mockLogoutService.givenRefreshToken).Return(tc.mockLogoutService.err),
+				}
+			}
+			instance := NewUserHandler(mockUserService)
+			handler := instance.Logout()
+			handler.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expStatusCode, res.Code)
+			if tc.expResponse != "" {
+				require.JSONEq(t, tc.expResponse, res.Body.String())
+			}
+		})
+	}
+}
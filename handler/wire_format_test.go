@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"bytes"
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// go test ./handler -run TestWireFormat -update rewrites the golden files
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestWireFormat(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	deletedAt := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	// a sonyflake id above 2^53, JavaScript would round it as a number
+	live := model.Product{
+		ID:        452434285453164545,
+		Name:      "running shoes",
+		Price:     model.Money{Amount: 12999, Currency: "USD"},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	deleted := model.Product{
+		ID:        452434285453164546,
+		Name:      "sandals",
+		Price:     model.Money{Amount: 4500, Currency: "JPY"},
+		CreatedAt: createdAt,
+		UpdatedAt: deletedAt,
+		DeletedAt: deletedAt,
+	}
+
+	tcs := map[string]struct {
+		givenBody interface{}
+	}{
+		"product": {
+			givenBody: toProductResponse(live),
+		},
+		"product_local_price": {
+			givenBody: func() productResponse {
+				res := toProductResponse(live)
+				res.LocalPrice = toLocalPriceResponse(model.LocalPrice{
+					Price:  model.Money{Amount: 12050, Currency: "EUR"},
+					Source: model.PriceSourceExchangeRate,
+				})
+				return res
+			}(),
+		},
+		"product_deleted": {
+			givenBody: toProductResponse(deleted),
+		},
+		"product_list": {
+			givenBody: model.ListResponse{
+				Data:       toProductResponses([]model.Product{live, deleted}),
+				Total:      3,
+				NextCursor: encodeCursor(deleted.ID),
+			},
+		},
+		"search_results": {
+			givenBody: model.ListResponse{
+				Data: toSearchResultResponses([]model.SearchResult{
+					{Product: live, Rank: 0.5, Snippet: "running <mark>shoes</mark>"},
+				}),
+				Total: 1,
+			},
+		},
+		"price_list": {
+			givenBody: model.ListResponse{
+				Data: toPriceResponses([]model.ProductPrice{
+					{
+						ID:        452434285453164547,
+						ProductID: live.ID,
+						Price:     model.Money{Amount: 11995, Currency: "CHF"},
+						ValidFrom: createdAt,
+						ValidTo:   deletedAt,
+						CreatedAt: createdAt,
+					},
+					{
+						ID:        452434285453164548,
+						ProductID: live.ID,
+						Price:     model.Money{Amount: 18500, Currency: "JPY"},
+						ValidFrom: createdAt,
+						CreatedAt: createdAt,
+					},
+				}),
+				Total: 2,
+			},
+		},
+		"price_history": {
+			givenBody: model.ListResponse{
+				Data: toPriceChangeResponses([]model.PriceChange{
+					{
+						ID:          452434285453164550,
+						ProductID:   live.ID,
+						Price:       model.Money{Amount: 9999, Currency: "USD"},
+						ChangeNote:  model.ChangeNote{ChangedBy: model.Actor{APIKeyID: 452434285453164551}, Reason: "black friday"},
+						EffectiveAt: deletedAt,
+						CreatedAt:   createdAt,
+					},
+					{
+						ID:          452434285453164549,
+						ProductID:   live.ID,
+						Price:       model.Money{Amount: 12999, Currency: "USD"},
+						Previous:    model.Money{Amount: 11999, Currency: "USD"},
+						ChangeNote:  model.ChangeNote{ChangedBy: model.Actor{UserID: 452434285453164552}, Reason: "supplier price increase"},
+						EffectiveAt: createdAt,
+						AppliedAt:   createdAt,
+						CreatedAt:   createdAt,
+					},
+				}),
+				Total: 2,
+			},
+		},
+		"category_list": {
+			givenBody: model.ListResponse{
+				Data: toCategoryResponses([]model.Category{
+					{ID: 452434285453164551, Name: "clothing", CreatedAt: createdAt, UpdatedAt: createdAt},
+					{ID: 452434285453164552, ParentID: 452434285453164551, Name: "shoes", CreatedAt: createdAt, UpdatedAt: deletedAt},
+				}),
+				Total: 2,
+			},
+		},
+		"exchange_rates": {
+			givenBody: toExchangeRatesResponse(model.ExchangeRates{
+				Base: "EUR",
+				Rates: map[string]decimal.Decimal{
+					"USD": decimal.RequireFromString("1.0832"),
+					"JPY": decimal.RequireFromString("163.47"),
+				},
+				UpdatedAt: createdAt,
+			}),
+		},
+		"response": {
+			givenBody: model.Response{
+				Code:        404,
+				Description: "Product not found",
+			},
+		},
+		"problem": {
+			givenBody: model.Problem{
+				Type:     "/problems/validation-error",
+				Title:    "Unprocessable Entity",
+				Status:   422,
+				Detail:   "Validation failed: name is required",
+				Instance: "host/abc-000001",
+				Errors:   []apperr.FieldViolation{{Field: "name", Message: "is required"}},
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			golden := filepath.Join("testdata", name+".golden.json")
+
+			// When
+			var body bytes.Buffer
+			require.NoError(t, json.NewEncoder(&body).Encode(tc.givenBody))
+
+			// Then
+			if *update {
+				var indented bytes.Buffer
+				require.NoError(t, json.Indent(&indented, body.Bytes(), "", "  "))
+				require.NoError(t, os.WriteFile(golden, indented.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), body.String())
+		})
+	}
+}
+
+func TestWireFormat_LegacyError(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodGet, "/products/1", nil)
+	res := httptest.NewRecorder()
+	handler := ErrHandler(func(w http.ResponseWriter, r *http.Request) error {
+		return apperr.NotFound("product", sql.ErrNoRows)
+	})
+
+	// When
+	handler.ServeHTTP(res, req)
+
+	// Then
+	expected, err := os.ReadFile(filepath.Join("testdata", "response.golden.json"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.JSONEq(t, string(expected), res.Body.String())
+}
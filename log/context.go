@@ -0,0 +1,32 @@
+package log
+
+import "context"
+
+type ctxKey struct{}
+
+// requestLogger is shared by pointer so fields added deep in the handler
+// chain, like the user id, also show up in the access log.
+type requestLogger struct {
+	logger Logger
+}
+
+// NewContext returns ctx carrying logger.
+func NewContext(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, &requestLogger{logger: logger})
+}
+
+// FromContext returns the request logger of ctx, Default when there is none.
+func FromContext(ctx context.Context) Logger {
+	if rl, ok := ctx.Value(ctxKey{}).(*requestLogger); ok {
+		return rl.logger
+	}
+	return Default()
+}
+
+// AddFields adds args to the logger of ctx in place, for every later entry of
+// the request. It does nothing when ctx has no logger.
+func AddFields(ctx context.Context, args ...interface{}) {
+	if rl, ok := ctx.Value(ctxKey{}).(*requestLogger); ok {
+		rl.logger = rl.logger.With(args...)
+	}
+}
@@ -0,0 +1,72 @@
+package log
+
+import (
+	"chi-demo/config"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// Logger is a leveled structured logger. The args are key-value pairs, e.g.
+// logger.Info("product created", "product_id", id).
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+	// With returns a logger adding args to every entry
+	With(args ...interface{}) Logger
+}
+
+type loggerImpl struct {
+	logger *slog.Logger
+}
+
+// New returns a logger writing to w at the configured level, as JSON or as
+// human readable key=value lines for the console format.
+func New(cfg config.LogConfig, w io.Writer) (Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("log: %w", err)
+	}
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch cfg.Format {
+	case "json":
+		handler = slog.NewJSONHandler(w, opts)
+	case "console":
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("log: unknown format %q", cfg.Format)
+	}
+
+	return loggerImpl{logger: slog.New(handler)}, nil
+}
+
+// Default returns the logger used before the configuration is loaded and
+// for contexts without a request logger.
+func Default() Logger {
+	return loggerImpl{logger: slog.New(slog.NewJSONHandler(os.Stderr, nil))}
+}
+
+func (l loggerImpl) Debug(msg string, args ...interface{}) {
+	l.logger.Debug(msg, args...)
+}
+
+func (l loggerImpl) Info(msg string, args ...interface{}) {
+	l.logger.Info(msg, args...)
+}
+
+func (l loggerImpl) Warn(msg string, args ...interface{}) {
+	l.logger.Warn(msg, args...)
+}
+
+func (l loggerImpl) Error(msg string, args ...interface{}) {
+	l.logger.Error(msg, args...)
+}
+
+func (l loggerImpl) With(args ...interface{}) Logger {
+	return loggerImpl{logger: l.logger.With(args...)}
+}
@@ -0,0 +1,97 @@
+package log
+
+import (
+	"bytes"
+	"chi-demo/config"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	type args struct {
+		givenConfig config.LogConfig
+		expOutput   string
+		expErr      string
+	}
+
+	tcs := map[string]args{
+		"success - json": {
+			givenConfig: config.LogConfig{Level: "info", Format: "json"},
+			expOutput:   `"level":"INFO","msg":"product created","product_id":1,"name":"shoes"`,
+		},
+		"success - console": {
+			givenConfig: config.LogConfig{Level: "debug", Format: "console"},
+			expOutput:   `level=INFO msg="product created" product_id=1 name=shoes`,
+		},
+		"err - unknown level": {
+			givenConfig: config.LogConfig{Level: "verbose", Format: "json"},
+			expErr:      `log: slog: level string "verbose": unknown name`,
+		},
+		"err - unknown format": {
+			givenConfig: config.LogConfig{Level: "info", Format: "xml"},
+			expErr:      `log: unknown format "xml"`,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			var out bytes.Buffer
+
+			// When
+			logger, err := New(tc.givenConfig, &out)
+
+			// Then
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			logger.With("product_id", 1).Info("product created", "name", "shoes")
+			require.Contains(t, out.String(), tc.expOutput)
+		})
+	}
+}
+
+func TestLogger_Level(t *testing.T) {
+	// Given
+	var out bytes.Buffer
+	logger, err := New(config.LogConfig{Level: "warn", Format: "json"}, &out)
+	require.NoError(t, err)
+
+	// When
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Warn("warn")
+	logger.Error("error")
+
+	// Then
+	var levels []string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		levels = append(levels, entry["level"].(string))
+	}
+	require.Equal(t, []string{"WARN", "ERROR"}, levels)
+}
+
+func TestAddFields(t *testing.T) {
+	// Given
+	var out bytes.Buffer
+	logger, err := New(config.LogConfig{Level: "info", Format: "json"}, &out)
+	require.NoError(t, err)
+	ctx := NewContext(context.Background(), logger)
+
+	// When
+	AddFields(ctx, "user_id", 7)
+	// a context without logger is left alone
+	AddFields(context.Background(), "user_id", 8)
+	FromContext(ctx).Info("hello")
+
+	// Then
+	require.Contains(t, out.String(), `"msg":"hello","user_id":7`)
+}
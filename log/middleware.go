@@ -0,0 +1,52 @@
+package log
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware puts a logger carrying the request and trace ids in the request
+// context, then writes an access log entry once the request is served. It
+// must be placed after middleware.RequestID and the tracing middleware.
+func Middleware(logger Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			fields := []interface{}{"request_id", middleware.GetReqID(r.Context())}
+			if span := trace.SpanContextFromContext(r.Context()); span.IsValid() {
+				fields = append(fields, "trace_id", span.TraceID().String())
+			}
+			ctx := NewContext(r.Context(), logger.With(fields...))
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			args := []interface{}{
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", status,
+				"bytes", ww.BytesWritten(),
+				"duration_ms", float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				args = append(args, "route", rctx.RoutePattern())
+			}
+
+			requestLogger := FromContext(ctx)
+			if status >= http.StatusInternalServerError {
+				requestLogger.Error("request served", args...)
+			} else {
+				requestLogger.Info("request served", args...)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package log
+
+import (
+	"bytes"
+	"chi-demo/config"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) {
+	type args struct {
+		givenPath  string
+		givenTrace bool
+		expEntries []map[string]interface{}
+	}
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+
+	tcs := map[string]args{
+		"success": {
+			givenPath:  "/products/1",
+			givenTrace: true,
+			expEntries: []map[string]interface{}{
+				{
+					"level":      "INFO",
+					"msg":        "loading product",
+					"request_id": "req-1",
+					"trace_id":   "4bf92f3577b34da6a3ce929d0e0e4736",
+					"user_id":    float64(7),
+				},
+				{
+					"level":      "INFO",
+					"msg":        "request served",
+					"request_id": "req-1",
+					"trace_id":   "4bf92f3577b34da6a3ce929d0e0e4736",
+					"user_id":    float64(7),
+					"method":     "GET",
+					"path":       "/products/1",
+					"route":      "/products/{id}",
+					"status":     float64(200),
+					"bytes":      float64(2),
+				},
+			},
+		},
+		"server error without trace": {
+			givenPath: "/products/0",
+			expEntries: []map[string]interface{}{
+				{
+					"level":      "INFO",
+					"msg":        "loading product",
+					"request_id": "req-1",
+					"user_id":    float64(7),
+				},
+				{
+					"level":      "ERROR",
+					"msg":        "request served",
+					"request_id": "req-1",
+					"user_id":    float64(7),
+					"method":     "GET",
+					"path":       "/products/0",
+					"route":      "/products/{id}",
+					"status":     float64(500),
+					"bytes":      float64(0),
+				},
+			},
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			var out bytes.Buffer
+			logger, err := New(config.LogConfig{Level: "info", Format: "json"}, &out)
+			require.NoError(t, err)
+
+			r := chi.NewRouter()
+			r.Use(Middleware(logger))
+			r.Get("/products/{id}", func(w http.ResponseWriter, r *http.Request) {
+				// set by the authentication deeper in the chain
+				AddFields(r.Context(), "user_id", 7)
+				FromContext(r.Context()).Info("loading product")
+				if chi.URLParam(r, "id") == "0" {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Write([]byte("ok"))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tc.givenPath, nil)
+			ctx := req.Context()
+			ctx = context.WithValue(ctx, middleware.RequestIDKey, "req-1")
+			if tc.givenTrace {
+				ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+					TraceID: traceID,
+					SpanID:  spanID,
+				}))
+			}
+			res := httptest.NewRecorder()
+
+			// When
+			r.ServeHTTP(res, req.WithContext(ctx))
+
+			// Then
+			decoder := json.NewDecoder(&out)
+			for _, expEntry := range tc.expEntries {
+				var entry map[string]interface{}
+				require.NoError(t, decoder.Decode(&entry))
+				require.NotEmpty(t, entry["time"])
+				delete(entry, "time")
+				if entry["msg"] == "request served" {
+					require.GreaterOrEqual(t, entry["duration_ms"], float64(0))
+					delete(entry, "duration_ms")
+				}
+				require.Equal(t, expEntry, entry)
+			}
+			require.False(t, decoder.More())
+		})
+	}
+}
@@ -9,14 +9,56 @@ type MockLogger struct {
 	mock.Mock
 }
 
-// Printf provides a mock function with given fields: msg, args
-func (_m *MockLogger) Printf(msg string, args ...interface{}) {
+// Debug provides a mock function with given fields: msg, args
+func (_m *MockLogger) Debug(msg string, args ...interface{}) {
 	var _ca []interface{}
 	_ca = append(_ca, msg)
 	_ca = append(_ca, args...)
 	_m.Called(_ca...)
 }
 
+// Error provides a mock function with given fields: msg, args
+func (_m *MockLogger) Error(msg string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, msg)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Info provides a mock function with given fields: msg, args
+func (_m *MockLogger) Info(msg string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, msg)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Warn provides a mock function with given fields: msg, args
+func (_m *MockLogger) Warn(msg string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, msg)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// With provides a mock function with given fields: args
+func (_m *MockLogger) With(args ...interface{}) Logger {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	var r0 Logger
+	if rf, ok := ret.Get(0).(func(...interface{}) Logger); ok {
+		r0 = rf(args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(Logger)
+		}
+	}
+
+	return r0
+}
+
 // NewMockLogger creates a new instance of MockLogger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockLogger(t interface {
@@ -1,13 +1,22 @@
 package main
 
 import (
+	"chi-demo/auth"
+	"chi-demo/config"
+	"chi-demo/data"
 	"chi-demo/db"
 	"chi-demo/handler"
+	"chi-demo/metrics"
 	"chi-demo/repository"
 	"chi-demo/route"
 	"chi-demo/service"
+	"chi-demo/tracing"
+	"context"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
@@ -16,41 +25,157 @@ import (
 	"chi-demo/log"
 )
 
-func router(productHandler handler.ProductHandler) http.Handler {
+func router(cfg config.Config, logger log.Logger, m *metrics.Metrics, tokenAuth *auth.JWTAuth, productHandler handler.ProductHandler, priceHandler handler.PriceHandler, categoryHandler handler.CategoryHandler, userHandler handler.UserHandler, apiKeyHandler handler.APIKeyHandler, healthHandler handler.HealthHandler) http.Handler {
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
-	r.Use(middleware.Logger)
+	r.Use(tracing.Middleware)
+	r.Use(m.Middleware)
+	r.Use(log.Middleware(logger))
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.URLFormat)
+	r.Use(handler.LimitBody(int64(cfg.API.MaxBodyBytes)))
 
-	route.InitRouter(r, productHandler)
+	route.InitRouter(r, cfg, tokenAuth, productHandler, priceHandler, categoryHandler, userHandler, apiKeyHandler, healthHandler, m.Handler())
 
 	return r
 }
 
 func main() {
-	// admin := model.User{
-	// 	Username: "nguyen",
-	// 	Password: "nguyen",
-	// }
+	if err := run(); err != nil {
+		log.Default().Error("startup failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// CONFIG_FILE is optional, the defaults and the environment may be enough
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		return err
+	}
+
+	logger, err := log.New(cfg.Log, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	db, err := db.Init(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("closing database", "error", err)
+		}
+	}()
+
+	tokenAuth, err := auth.NewFromConfig(cfg.JWT)
+	if err != nil {
+		return err
+	}
+
+	shutdownTracing, err := tracing.Init(ctx, cfg.Tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// ctx is already cancelled on shutdown, flushing needs its own deadline
+		flushCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			logger.Error("flushing traces", "error", err)
+		}
+	}()
+	// the health checks are left out so probes do not flood the traces
+	tracedDB := tracing.WrapExecutor(db)
+
+	m := metrics.New()
+	if err := m.RegisterDB(db, "postgres"); err != nil {
+		return err
+	}
+
+	productRepoImpl, err := repository.New(tracedDB)
+	if err != nil {
+		return err
+	}
+	productRepo := repository.NewProductRepositoryMetrics(productRepoImpl, m)
+	productService := service.NewProductServiceMetrics(service.New(productRepo), m)
+
+	priceRepo, err := repository.NewPriceRepository(tracedDB)
+	if err != nil {
+		return err
+	}
+	exchangeRateRepo := repository.NewExchangeRateRepository(tracedDB)
+	priceChangeRepo, err := repository.NewPriceChangeRepository(tracedDB)
+	if err != nil {
+		return err
+	}
+	priceService := service.NewPriceService(productRepo, priceRepo, exchangeRateRepo, priceChangeRepo)
+	priceHandler := handler.NewPriceHandler(priceService)
+	productHandler := handler.New(productService, priceService, cfg)
+
+	categoryRepo, err := repository.NewCategoryRepository(tracedDB)
+	if err != nil {
+		return err
+	}
+	categoryService := service.NewCategoryService(categoryRepo, productRepo)
+	categoryHandler := handler.NewCategoryHandler(categoryService, cfg)
+
+	userRepo, err := repository.NewUserRepository(tracedDB)
+	if err != nil {
+		return err
+	}
+	tokenRepo, err := repository.NewTokenRepository(tracedDB)
+	if err != nil {
+		return err
+	}
+	userService := service.NewUserService(userRepo, tokenRepo, tokenAuth)
+	userHandler := handler.NewUserHandler(userService)
+
+	apiKeyRepo, err := repository.NewAPIKeyRepository(tracedDB)
+	if err != nil {
+		return err
+	}
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+
+	schemaVersion, err := data.LatestMigration()
+	if err != nil {
+		return err
+	}
+	healthRepo := repository.NewHealthRepository(db)
+	healthService := service.NewHealthService(healthRepo, schemaVersion)
+	healthHandler := handler.NewHealthHandler(healthService)
 
-	logger := log.GetLogger()
+	server := newServer(cfg.Server, router(cfg, logger, m, tokenAuth, productHandler, priceHandler, categoryHandler, userHandler, apiKeyHandler, healthHandler))
 
-	db, err := db.Init()
+	// listen before logging so a taken port fails the startup
+	listener, err := net.Listen("tcp", server.Addr)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	productRepo := repository.New(db)
-	productService := service.New(productRepo)
-	productHandler := handler.New(productService)
+	// the scheduler must stop before the database is closed
+	schedulerCtx, stopScheduler := context.WithCancel(ctx)
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		service.NewPriceScheduler(priceService, cfg.Pricing.SchedulerInterval, logger).Run(schedulerCtx)
+	}()
+	defer func() {
+		stopScheduler()
+		<-schedulerDone
+	}()
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "3333"
+	logger.Info("server started", "port", cfg.Server.Port)
+	if err := serve(ctx, server, listener, cfg.Server, healthService.Drain); err != nil {
+		return err
 	}
 
-	logger.Printf("Running on port %s\n", port)
-	http.ListenAndServe(":"+port, router(productHandler))
+	logger.Info("server stopped")
+	return nil
 }
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	statusOK    = "ok"
+	statusError = "error"
+)
+
+// Metrics owns the collectors exposed on /metrics. A dedicated registry keeps
+// tests independent from each other and from the global one.
+type Metrics struct {
+	registry        *prometheus.Registry
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	queryDuration   *prometheus.HistogramVec
+	productsCreated prometheus.Counter
+	productsDeleted prometheus.Counter
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests by route pattern and status code.",
+		}, []string{"method", "route", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by route pattern and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "Repository method latency, status is error when the method failed.",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"repository", "method", "status"}),
+		productsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "products_created_total",
+			Help: "Products created.",
+		}),
+		productsDeleted: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "products_deleted_total",
+			Help: "Products soft deleted.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.requestDuration,
+		m.queryDuration,
+		m.productsCreated,
+		m.productsDeleted,
+	)
+
+	return m
+}
+
+// RegisterDB exposes the connection pool stats of db as go_sql_* gauges.
+func (m *Metrics) RegisterDB(db *sql.DB, name string) error {
+	return m.registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveQuery records the duration of a repository method started at start.
+// sql.ErrNoRows is a valid outcome, not a failure.
+func (m *Metrics) ObserveQuery(repository, method string, start time.Time, err error) {
+	status := statusOK
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		status = statusError
+	}
+	m.queryDuration.WithLabelValues(repository, method, status).Observe(time.Since(start).Seconds())
+}
+
+func (m *Metrics) ProductCreated() {
+	m.productsCreated.Inc()
+}
+
+func (m *Metrics) ProductDeleted() {
+	m.productsDeleted.Inc()
+}
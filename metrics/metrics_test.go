@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-chi/chi"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestMetrics_Middleware(t *testing.T) {
+	type args struct {
+		givenPath   string
+		expRoute    string
+		expStatus   string
+		expRequests float64
+	}
+
+	tcs := map[string]args{
+		"success - route pattern": {
+			givenPath:   "/products/1",
+			expRoute:    "/products/{id}",
+			expStatus:   "200",
+			expRequests: 1,
+		},
+		"error status": {
+			givenPath:   "/products/0",
+			expRoute:    "/products/{id}",
+			expStatus:   "404",
+			expRequests: 1,
+		},
+		"unmatched route": {
+			givenPath:   "/unknown/path",
+			expRoute:    unmatchedRoute,
+			expStatus:   "404",
+			expRequests: 1,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			m := New()
+			r := chi.NewRouter()
+			r.Use(m.Middleware)
+			r.Get("/products/{id}", func(w http.ResponseWriter, r *http.Request) {
+				if chi.URLParam(r, "id") == "0" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte("ok"))
+			})
+			req := httptest.NewRequest(http.MethodGet, tc.givenPath, nil)
+			res := httptest.NewRecorder()
+
+			// When
+			r.ServeHTTP(res, req)
+
+			// Then
+			require.Equal(t, tc.expRequests, testutil.ToFloat64(m.requests.WithLabelValues(http.MethodGet, tc.expRoute, tc.expStatus)))
+			require.Equal(t, 1, testutil.CollectAndCount(m.requestDuration))
+		})
+	}
+}
+
+func TestMetrics_ObserveQuery(t *testing.T) {
+	tcs := map[string]struct {
+		givenErr  error
+		expStatus string
+	}{
+		"success":   {expStatus: statusOK},
+		"not found": {givenErr: sql.ErrNoRows, expStatus: statusOK},
+		"error":     {givenErr: errors.New("test"), expStatus: statusError},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			m := New()
+
+			// When
+			m.ObserveQuery("product", "GetOne", time.Now(), tc.givenErr)
+
+			// Then
+			require.Equal(t, 1, testutil.CollectAndCount(m.queryDuration))
+			histogram := &dto.Metric{}
+			require.NoError(t, m.queryDuration.WithLabelValues("product", "GetOne", tc.expStatus).(prometheus.Histogram).Write(histogram))
+			require.Equal(t, uint64(1), histogram.GetHistogram().GetSampleCount())
+		})
+	}
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	// Given
+	m := New()
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, m.RegisterDB(db, "postgres"))
+	m.ProductCreated()
+	m.ProductDeleted()
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	res := httptest.NewRecorder()
+
+	// When
+	m.Handler().ServeHTTP(res, req)
+
+	// Then
+	require.Equal(t, http.StatusOK, res.Code)
+	body := res.Body.String()
+	require.Contains(t, body, "products_created_total 1")
+	require.Contains(t, body, "products_deleted_total 1")
+	require.Contains(t, body, `go_sql_open_connections{db_name="postgres"}`)
+	require.Contains(t, body, `go_sql_max_open_connections{db_name="postgres"}`)
+}
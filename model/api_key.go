@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Scope grants an API key access to a set of routes.
+type Scope string
+
+const (
+	ScopeProductsRead  Scope = "products:read"
+	ScopeProductsWrite Scope = "products:write"
+)
+
+// Scopes lists every scope an API key can be granted.
+var Scopes = []Scope{ScopeProductsRead, ScopeProductsWrite}
+
+// RoleScopes are the scopes users get from their role.
+var RoleScopes = map[Role][]Scope{
+	RoleAdmin:  {ScopeProductsRead, ScopeProductsWrite},
+	RoleEditor: {ScopeProductsRead, ScopeProductsWrite},
+	RoleViewer: {ScopeProductsRead},
+}
+
+type APIKey struct {
+	ID   int64
+	Name string
+	// KeyHash is the sha256 of the key, the key itself is never stored
+	KeyHash   string
+	Scopes    []Scope
+	CreatedBy int64
+	RevokedAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
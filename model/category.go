@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// Category is a node of the product taxonomy, products in a category are
+// also in all of its ancestors.
+type Category struct {
+	ID int64
+	// ParentID is zero for a root category
+	ParentID  int64
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,28 @@
+package model
+
+type HealthStatus string
+
+const (
+	HealthStatusOK   HealthStatus = "ok"
+	HealthStatusFail HealthStatus = "fail"
+)
+
+// HealthCheck is the outcome of checking a single dependency.
+type HealthCheck struct {
+	Name      string       `json:"name"`
+	Status    HealthStatus `json:"status"`
+	LatencyMs float64      `json:"latency_ms"`
+	Error     string       `json:"error,omitempty"`
+}
+
+// HealthReport is ok only when every check is.
+type HealthReport struct {
+	Status HealthStatus  `json:"status"`
+	Checks []HealthCheck `json:"checks,omitempty"`
+}
+
+// SchemaVersion is the state recorded by golang-migrate in schema_migrations.
+type SchemaVersion struct {
+	Version int64
+	Dirty   bool
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Money is an amount in the minor units of an ISO 4217 currency, 1250 USD
+// is $12.50.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// currency describes the minor unit of a supported currency.
+type currency struct {
+	// exponent is the number of minor unit digits
+	exponent int32
+	// increment is the step, in minor units, converted amounts are rounded
+	// to
+	increment int64
+}
+
+// currencies are the supported currencies by ISO 4217 code.
+var currencies = map[string]currency{
+	// Swiss prices are rounded to 5 centimes
+	"CHF": {exponent: 2, increment: 5},
+	"EUR": {exponent: 2, increment: 1},
+	"GBP": {exponent: 2, increment: 1},
+	"JPY": {exponent: 0, increment: 1},
+	"USD": {exponent: 2, increment: 1},
+}
+
+// DefaultCurrency is the currency of the prices stored before currencies
+// were introduced.
+const DefaultCurrency = "USD"
+
+// SupportedCurrencies returns the sorted codes of the supported currencies.
+func SupportedCurrencies() []string {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// IsSupportedCurrency reports whether code is a supported ISO 4217 code.
+func IsSupportedCurrency(code string) bool {
+	_, ok := currencies[code]
+	return ok
+}
+
+// Decimal returns the amount in major units.
+func (m Money) Decimal() decimal.Decimal {
+	return decimal.New(m.Amount, -currencies[m.Currency].exponent)
+}
+
+// Convert converts m to the currency to, rate being how many units of to one
+// unit of m.Currency is worth. The amount is rounded half away from zero to
+// the rounding increment of to, a positive amount to at least one increment.
+func (m Money) Convert(to string, rate decimal.Decimal) (Money, error) {
+	cur, ok := currencies[to]
+	if !ok {
+		return Money{}, fmt.Errorf("unsupported currency %q", to)
+	}
+	if !rate.IsPositive() {
+		return Money{}, fmt.Errorf("invalid exchange rate %s", rate)
+	}
+
+	increment := decimal.NewFromInt(cur.increment)
+	minor := m.Decimal().Mul(rate).Shift(cur.exponent).Div(increment).Round(0).Mul(increment)
+	if minor.IsZero() && m.Amount > 0 {
+		minor = increment
+	}
+	if !minor.Equal(decimal.NewFromInt(minor.IntPart())) {
+		return Money{}, fmt.Errorf("%s overflows the amount", m.Decimal().Mul(rate))
+	}
+
+	return Money{Amount: minor.IntPart(), Currency: to}, nil
+}
+
+// String formats the amount in major units for display, e.g. "12.50 USD".
+func (m Money) String() string {
+	return m.Decimal().StringFixed(currencies[m.Currency].exponent) + " " + m.Currency
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_String(t *testing.T) {
+	tcs := map[string]struct {
+		givenMoney Money
+		expString  string
+	}{
+		"cents": {
+			givenMoney: Money{Amount: 1250, Currency: "USD"},
+			expString:  "12.50 USD",
+		},
+		"below one": {
+			givenMoney: Money{Amount: 5, Currency: "EUR"},
+			expString:  "0.05 EUR",
+		},
+		"no minor unit": {
+			givenMoney: Money{Amount: 1250, Currency: "JPY"},
+			expString:  "1250 JPY",
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			require.Equal(t, tc.expString, tc.givenMoney.String())
+		})
+	}
+}
+
+func TestMoney_Convert(t *testing.T) {
+	type args struct {
+		givenMoney Money
+		givenTo    string
+		givenRate  string
+		expMoney   Money
+		expErr     string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenMoney: Money{Amount: 1000, Currency: "EUR"},
+			givenTo:    "USD",
+			givenRate:  "1.08",
+			expMoney:   Money{Amount: 1080, Currency: "USD"},
+		},
+		"success - half rounds away from zero": {
+			givenMoney: Money{Amount: 1250, Currency: "EUR"},
+			givenTo:    "USD",
+			givenRate:  "1.1",
+			expMoney:   Money{Amount: 1375, Currency: "USD"},
+		},
+		"success - rounds to the increment": {
+			givenMoney: Money{Amount: 1000, Currency: "EUR"},
+			givenTo:    "CHF",
+			givenRate:  "0.9437",
+			expMoney:   Money{Amount: 945, Currency: "CHF"},
+		},
+		"success - no minor unit": {
+			givenMoney: Money{Amount: 1999, Currency: "USD"},
+			givenTo:    "JPY",
+			givenRate:  "151.234",
+			expMoney:   Money{Amount: 3023, Currency: "JPY"},
+		},
+		"success - at least one increment": {
+			givenMoney: Money{Amount: 1, Currency: "JPY"},
+			givenTo:    "USD",
+			givenRate:  "0.0066",
+			expMoney:   Money{Amount: 1, Currency: "USD"},
+		},
+		"err - unsupported currency": {
+			givenMoney: Money{Amount: 1000, Currency: "EUR"},
+			givenTo:    "XXX",
+			givenRate:  "1",
+			expErr:     `unsupported currency "XXX"`,
+		},
+		"err - invalid rate": {
+			givenMoney: Money{Amount: 1000, Currency: "EUR"},
+			givenTo:    "USD",
+			givenRate:  "0",
+			expErr:     "invalid exchange rate 0",
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// When
+			money, err := tc.givenMoney.Convert(tc.givenTo, decimal.RequireFromString(tc.givenRate))
+
+			// Then
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expMoney, money)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ProductPrice is an explicit price of a product in one market currency,
+// valid from ValidFrom until ValidTo.
+type ProductPrice struct {
+	ID        int64
+	ProductID int64
+	Price     Money
+	ValidFrom time.Time
+	// ValidTo is exclusive, zero when the price is open-ended
+	ValidTo   time.Time
+	CreatedAt time.Time
+}
+
+// ValidAt reports whether the price applies at t.
+func (p ProductPrice) ValidAt(t time.Time) bool {
+	return !t.Before(p.ValidFrom) && (p.ValidTo.IsZero() || t.Before(p.ValidTo))
+}
+
+// ExchangeRates are how many units of each currency one unit of Base is
+// worth, e.g. 1.08 USD for a EUR base.
+type ExchangeRates struct {
+	Base      string
+	Rates     map[string]decimal.Decimal
+	UpdatedAt time.Time
+}
+
+// Rate returns how many units of to one unit of from is worth, crossing
+// through the base when neither is the base, false when a rate is
+// missing.
+func (r ExchangeRates) Rate(from, to string) (decimal.Decimal, bool) {
+	fromRate, ok := r.baseRate(from)
+	if !ok {
+		return decimal.Decimal{}, false
+	}
+	toRate, ok := r.baseRate(to)
+	if !ok {
+		return decimal.Decimal{}, false
+	}
+	return toRate.Div(fromRate), true
+}
+
+func (r ExchangeRates) baseRate(code string) (decimal.Decimal, bool) {
+	if code == r.Base && code != "" {
+		return decimal.NewFromInt(1), true
+	}
+	rate, ok := r.Rates[code]
+	return rate, ok
+}
+
+// PriceSource tells where a LocalPrice comes from.
+type PriceSource string
+
+const (
+	// PriceSourceBase is the product price itself, already in the currency
+	PriceSourceBase PriceSource = "base"
+	// PriceSourcePriceList is an explicit ProductPrice
+	PriceSourcePriceList PriceSource = "price_list"
+	// PriceSourceExchangeRate is the product price converted at the current
+	// exchange rate
+	PriceSourceExchangeRate PriceSource = "exchange_rate"
+)
+
+// LocalPrice is the price of a product in a requested currency.
+type LocalPrice struct {
+	Price  Money
+	Source PriceSource
+}
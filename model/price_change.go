@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// Actor is who made a change, either a user or an API key.
+type Actor struct {
+	UserID   int64
+	APIKeyID int64
+}
+
+// Actor returns who the principal acts as.
+func (p Principal) Actor() Actor {
+	return Actor{UserID: p.UserID, APIKeyID: p.APIKeyID}
+}
+
+// ChangeNote says who makes a change and why, for the price history.
+type ChangeNote struct {
+	ChangedBy Actor
+	Reason    string
+}
+
+// PriceChangeStatus tells whether a PriceChange was applied yet.
+type PriceChangeStatus string
+
+const (
+	PriceChangeScheduled PriceChangeStatus = "scheduled"
+	PriceChangeApplied   PriceChangeStatus = "applied"
+)
+
+// PriceChange is an entry of the price history of a product. A future-dated
+// change is scheduled until the scheduler applies it at EffectiveAt.
+type PriceChange struct {
+	ID        int64
+	ProductID int64
+	Price     Money
+	// Previous is the price replaced, zero until applied
+	Previous Money
+	ChangeNote
+	EffectiveAt time.Time
+	// AppliedAt is zero while the change is scheduled
+	AppliedAt time.Time
+	CreatedAt time.Time
+}
+
+func (c PriceChange) Status() PriceChangeStatus {
+	if c.AppliedAt.IsZero() {
+		return PriceChangeScheduled
+	}
+	return PriceChangeApplied
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRates_Rate(t *testing.T) {
+	rates := ExchangeRates{
+		Base: "EUR",
+		Rates: map[string]decimal.Decimal{
+			"USD": decimal.RequireFromString("1.25"),
+			"GBP": decimal.RequireFromString("0.5"),
+		},
+	}
+
+	tcs := map[string]struct {
+		givenFrom string
+		givenTo   string
+		expRate   string
+		expOK     bool
+	}{
+		"from the base": {
+			givenFrom: "EUR",
+			givenTo:   "USD",
+			expRate:   "1.25",
+			expOK:     true,
+		},
+		"to the base": {
+			givenFrom: "GBP",
+			givenTo:   "EUR",
+			expRate:   "2",
+			expOK:     true,
+		},
+		"cross rate": {
+			givenFrom: "GBP",
+			givenTo:   "USD",
+			expRate:   "2.5",
+			expOK:     true,
+		},
+		"missing rate": {
+			givenFrom: "USD",
+			givenTo:   "JPY",
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// When
+			rate, ok := rates.Rate(tc.givenFrom, tc.givenTo)
+
+			// Then
+			require.Equal(t, tc.expOK, ok)
+			if tc.expOK {
+				require.True(t, decimal.RequireFromString(tc.expRate).Equal(rate), rate.String())
+			}
+		})
+	}
+}
+
+func TestProductPrice_ValidAt(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tcs := map[string]struct {
+		givenPrice ProductPrice
+		givenAt    time.Time
+		expValid   bool
+	}{
+		"before": {
+			givenPrice: ProductPrice{ValidFrom: from, ValidTo: to},
+			givenAt:    from.Add(-time.Second),
+		},
+		"from is inclusive": {
+			givenPrice: ProductPrice{ValidFrom: from, ValidTo: to},
+			givenAt:    from,
+			expValid:   true,
+		},
+		"to is exclusive": {
+			givenPrice: ProductPrice{ValidFrom: from, ValidTo: to},
+			givenAt:    to,
+		},
+		"open-ended": {
+			givenPrice: ProductPrice{ValidFrom: from},
+			givenAt:    to.AddDate(10, 0, 0),
+			expValid:   true,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			require.Equal(t, tc.expValid, tc.givenPrice.ValidAt(tc.givenAt))
+		})
+	}
+}
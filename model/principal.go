@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Principal is whoever a request is authenticated as, either a user through
+// a JWT or a service through an API key.
+type Principal struct {
+	// UserID is set for users
+	UserID int64
+	Role   Role
+	// TokenID and TokenExpiresAt describe the user's access token
+	TokenID        string
+	TokenExpiresAt time.Time
+	// APIKeyID is set for API keys
+	APIKeyID int64
+	Scopes   []Scope
+}
+
+func (p Principal) HasScope(scope Scope) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package model
+
+import "chi-demo/apperr"
+
+// Problem is an RFC 7807 problem details document.
+type Problem struct {
+	Type     string                  `json:"type"`
+	Title    string                  `json:"title"`
+	Status   int                     `json:"status"`
+	Detail   string                  `json:"detail,omitempty"`
+	Instance string                  `json:"instance,omitempty"`
+	Errors   []apperr.FieldViolation `json:"errors,omitempty"`
+}
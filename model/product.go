@@ -5,8 +5,74 @@ import "time"
 type Product struct {
 	ID        int64
 	Name      string
-	Price     int
+	Price     Money
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt time.Time
 }
+
+// ReadOptions controls which products are visible to a read.
+type ReadOptions struct {
+	// IncludeDeleted also returns soft-deleted (tombstoned) products
+	IncludeDeleted bool
+}
+
+// ProductListQuery describes which page of products to list.
+type ProductListQuery struct {
+	ReadOptions
+
+	// Limit is the maximum number of products in the page
+	Limit int
+	// Offset skips that many products before the page starts
+	Offset int
+	// AfterID starts the page after the product with this id (keyset
+	// pagination); sonyflake ids are time-ordered so this is stable under
+	// concurrent inserts
+	AfterID int64
+	// CategoryID restricts the listing to the products of a category and of
+	// its descendants, zero lists every product
+	CategoryID int64
+
+	Filters []ProductFilter
+	// Sort orders the page; the id is always the final tie-breaker
+	Sort []ProductSort
+}
+
+// FilterOperator compares a product column with a filter value.
+type FilterOperator string
+
+const (
+	FilterEQ    FilterOperator = "eq"
+	FilterNEQ   FilterOperator = "neq"
+	FilterLT    FilterOperator = "lt"
+	FilterLTE   FilterOperator = "lte"
+	FilterGT    FilterOperator = "gt"
+	FilterGTE   FilterOperator = "gte"
+	FilterLike  FilterOperator = "like"
+	FilterILike FilterOperator = "ilike"
+)
+
+// ProductFilter restricts a listing to products whose Field compares to
+// Value with Operator. Value has the Go type of the column (int64 for id,
+// string for name and currency, int64 for the price amount, time.Time for
+// timestamps).
+type ProductFilter struct {
+	Field    string
+	Operator FilterOperator
+	Value    interface{}
+}
+
+// ProductSort orders a listing by a column.
+type ProductSort struct {
+	Field string
+	Desc  bool
+}
+
+// ProductPage is one page of a product listing.
+type ProductPage struct {
+	Products []Product
+	// Total is the number of products matching the query, ignoring paging
+	Total int64
+	// NextID is the id to continue after, or zero on the last page
+	NextID int64
+}
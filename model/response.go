@@ -1,6 +1,15 @@
 package model
 
+// Response is the legacy body of messages and errors, its keys stay
+// Code and Description for the clients parsing them.
 type Response struct {
 	Code        int
 	Description string
 }
+
+// ListResponse is the envelope of paginated list endpoints.
+type ListResponse struct {
+	Data       interface{} `json:"data"`
+	Total      int64       `json:"total"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,33 @@
+package model
+
+// SearchQuery is a free-text product search.
+type SearchQuery struct {
+	Text string
+	// Prefix also matches words starting with the last search term
+	Prefix bool
+	// Fuzzy matches names by trigram substring instead of full-text terms,
+	// for queries too short to be meaningful terms
+	Fuzzy bool
+}
+
+// Page is a limit/offset window into a result set.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// SearchResult is a product matched by a search, ordered by Rank.
+type SearchResult struct {
+	Product Product
+	Rank    float64
+	// Snippet is the HTML-escaped product name with matches wrapped in
+	// <mark></mark>
+	Snippet string
+}
+
+// SearchPage is one page of search results.
+type SearchPage struct {
+	Results []SearchResult
+	// Total is the number of matching products, ignoring paging
+	Total int64
+}
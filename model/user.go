@@ -1,7 +1,45 @@
 package model
 
+import "time"
+
+// Role grants access to a set of routes.
+type Role string
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
 type User struct {
 	ID       int64
 	Username string
-	Password string
+	// Password is the plaintext password, only set on input
+	Password     string
+	PasswordHash string
+	Role         Role
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// AuthToken is returned to clients on login and refresh.
+type AuthToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	// ExpiresIn is the access token lifetime in seconds
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshToken is a stored refresh token. Only the hash of the opaque token
+// handed to the client is kept.
+type RefreshToken struct {
+	ID     int64
+	UserID int64
+	// FamilyID is shared by all tokens rotated from the same login
+	FamilyID  int64
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+	CreatedAt time.Time
 }
@@ -4,9 +4,27 @@
 package models
 
 var TableNames = struct {
-	Product          string
-	SchemaMigrations string
+	APIKey             string
+	Category           string
+	ExchangeRate       string
+	PriceChange        string
+	Product            string
+	ProductCategory    string
+	ProductPrice       string
+	RefreshToken       string
+	RevokedAccessToken string
+	SchemaMigrations   string
+	User               string
 }{
-	Product:          "product",
-	SchemaMigrations: "schema_migrations",
+	APIKey:             "api_key",
+	Category:           "category",
+	ExchangeRate:       "exchange_rate",
+	PriceChange:        "price_change",
+	Product:            "product",
+	ProductCategory:    "product_category",
+	ProductPrice:       "product_price",
+	RefreshToken:       "refresh_token",
+	RevokedAccessToken: "revoked_access_token",
+	SchemaMigrations:   "schema_migrations",
+	User:               "user",
 }
@@ -0,0 +1,949 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// ExchangeRate is an object representing the database table.
+type ExchangeRate struct {
+	Currency  string        `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Base      string        `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Rate      types.Decimal `boil:"rate" json:"rate" toml:"rate" yaml:"rate"`
+	UpdatedAt time.Time     `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *exchangeRateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L exchangeRateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ExchangeRateColumns = struct {
+	Currency  string
+	Base      string
+	Rate      string
+	UpdatedAt string
+}{
+	Currency:  "currency",
+	Base:      "base",
+	Rate:      "rate",
+	UpdatedAt: "updated_at",
+}
+
+var ExchangeRateTableColumns = struct {
+	Currency  string
+	Base      string
+	Rate      string
+	UpdatedAt string
+}{
+	Currency:  "exchange_rate.currency",
+	Base:      "exchange_rate.base",
+	Rate:      "exchange_rate.rate",
+	UpdatedAt: "exchange_rate.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_Decimal struct{ field string }
+
+func (w whereHelpertypes_Decimal) EQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Decimal) NEQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Decimal) LT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Decimal) LTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Decimal) GT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Decimal) GTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var ExchangeRateWhere = struct {
+	Currency  whereHelperstring
+	Base      whereHelperstring
+	Rate      whereHelpertypes_Decimal
+	UpdatedAt whereHelpertime_Time
+}{
+	Currency:  whereHelperstring{field: "\"exchange_rate\".\"currency\""},
+	Base:      whereHelperstring{field: "\"exchange_rate\".\"base\""},
+	Rate:      whereHelpertypes_Decimal{field: "\"exchange_rate\".\"rate\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"exchange_rate\".\"updated_at\""},
+}
+
+// ExchangeRateRels is where relationship names are stored.
+var ExchangeRateRels = struct {
+}{}
+
+// exchangeRateR is where relationships are stored.
+type exchangeRateR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*exchangeRateR) NewStruct() *exchangeRateR {
+	return &exchangeRateR{}
+}
+
+// exchangeRateL is where Load methods for each relationship are stored.
+type exchangeRateL struct{}
+
+var (
+	exchangeRateAllColumns            = []string{"currency", "base", "rate", "updated_at"}
+	exchangeRateColumnsWithoutDefault = []string{"currency", "base", "rate", "updated_at"}
+	exchangeRateColumnsWithDefault    = []string{}
+	exchangeRatePrimaryKeyColumns     = []string{"currency"}
+	exchangeRateGeneratedColumns      = []string{}
+)
+
+type (
+	// ExchangeRateSlice is an alias for a slice of pointers to ExchangeRate.
+	// This should almost always be used instead of []ExchangeRate.
+	ExchangeRateSlice []*ExchangeRate
+	// ExchangeRateHook is the signature for custom ExchangeRate hook methods
+	ExchangeRateHook func(context.Context, boil.ContextExecutor, *ExchangeRate) error
+
+	exchangeRateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	exchangeRateType                 = reflect.TypeOf(&ExchangeRate{})
+	exchangeRateMapping              = queries.MakeStructMapping(exchangeRateType)
+	exchangeRatePrimaryKeyMapping, _ = queries.BindMapping(exchangeRateType, exchangeRateMapping, exchangeRatePrimaryKeyColumns)
+	exchangeRateInsertCacheMut       sync.RWMutex
+	exchangeRateInsertCache          = make(map[string]insertCache)
+	exchangeRateUpdateCacheMut       sync.RWMutex
+	exchangeRateUpdateCache          = make(map[string]updateCache)
+	exchangeRateUpsertCacheMut       sync.RWMutex
+	exchangeRateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var exchangeRateAfterSelectHooks []ExchangeRateHook
+
+var exchangeRateBeforeInsertHooks []ExchangeRateHook
+var exchangeRateAfterInsertHooks []ExchangeRateHook
+
+var exchangeRateBeforeUpdateHooks []ExchangeRateHook
+var exchangeRateAfterUpdateHooks []ExchangeRateHook
+
+var exchangeRateBeforeDeleteHooks []ExchangeRateHook
+var exchangeRateAfterDeleteHooks []ExchangeRateHook
+
+var exchangeRateBeforeUpsertHooks []ExchangeRateHook
+var exchangeRateAfterUpsertHooks []ExchangeRateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ExchangeRate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ExchangeRate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ExchangeRate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ExchangeRate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ExchangeRate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ExchangeRate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ExchangeRate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ExchangeRate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ExchangeRate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range exchangeRateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddExchangeRateHook registers your hook function for all future operations.
+func AddExchangeRateHook(hookPoint boil.HookPoint, exchangeRateHook ExchangeRateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		exchangeRateAfterSelectHooks = append(exchangeRateAfterSelectHooks, exchangeRateHook)
+	case boil.BeforeInsertHook:
+		exchangeRateBeforeInsertHooks = append(exchangeRateBeforeInsertHooks, exchangeRateHook)
+	case boil.AfterInsertHook:
+		exchangeRateAfterInsertHooks = append(exchangeRateAfterInsertHooks, exchangeRateHook)
+	case boil.BeforeUpdateHook:
+		exchangeRateBeforeUpdateHooks = append(exchangeRateBeforeUpdateHooks, exchangeRateHook)
+	case boil.AfterUpdateHook:
+		exchangeRateAfterUpdateHooks = append(exchangeRateAfterUpdateHooks, exchangeRateHook)
+	case boil.BeforeDeleteHook:
+		exchangeRateBeforeDeleteHooks = append(exchangeRateBeforeDeleteHooks, exchangeRateHook)
+	case boil.AfterDeleteHook:
+		exchangeRateAfterDeleteHooks = append(exchangeRateAfterDeleteHooks, exchangeRateHook)
+	case boil.BeforeUpsertHook:
+		exchangeRateBeforeUpsertHooks = append(exchangeRateBeforeUpsertHooks, exchangeRateHook)
+	case boil.AfterUpsertHook:
+		exchangeRateAfterUpsertHooks = append(exchangeRateAfterUpsertHooks, exchangeRateHook)
+	}
+}
+
+// One returns a single exchangeRate record from the query.
+func (q exchangeRateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ExchangeRate, error) {
+	o := &ExchangeRate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for exchange_rate")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ExchangeRate records from the query.
+func (q exchangeRateQuery) All(ctx context.Context, exec boil.ContextExecutor) (ExchangeRateSlice, error) {
+	var o []*ExchangeRate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ExchangeRate slice")
+	}
+
+	if len(exchangeRateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ExchangeRate records in the query.
+func (q exchangeRateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count exchange_rate rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q exchangeRateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if exchange_rate exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeRates retrieves all the records using an executor.
+func ExchangeRates(mods ...qm.QueryMod) exchangeRateQuery {
+	mods = append(mods, qm.From("\"exchange_rate\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"exchange_rate\".*"})
+	}
+
+	return exchangeRateQuery{q}
+}
+
+// FindExchangeRate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindExchangeRate(ctx context.Context, exec boil.ContextExecutor, currency string, selectCols ...string) (*ExchangeRate, error) {
+	exchangeRateObj := &ExchangeRate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"exchange_rate\" where \"currency\"=$1", sel,
+	)
+
+	q := queries.Raw(query, currency)
+
+	err := q.Bind(ctx, exec, exchangeRateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from exchange_rate")
+	}
+
+	if err = exchangeRateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return exchangeRateObj, err
+	}
+
+	return exchangeRateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ExchangeRate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no exchange_rate provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(exchangeRateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	exchangeRateInsertCacheMut.RLock()
+	cache, cached := exchangeRateInsertCache[key]
+	exchangeRateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			exchangeRateAllColumns,
+			exchangeRateColumnsWithDefault,
+			exchangeRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"exchange_rate\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"exchange_rate\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into exchange_rate")
+	}
+
+	if !cached {
+		exchangeRateInsertCacheMut.Lock()
+		exchangeRateInsertCache[key] = cache
+		exchangeRateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ExchangeRate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ExchangeRate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	exchangeRateUpdateCacheMut.RLock()
+	cache, cached := exchangeRateUpdateCache[key]
+	exchangeRateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			exchangeRateAllColumns,
+			exchangeRatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update exchange_rate, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"exchange_rate\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, exchangeRatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, append(wl, exchangeRatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update exchange_rate row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for exchange_rate")
+	}
+
+	if !cached {
+		exchangeRateUpdateCacheMut.Lock()
+		exchangeRateUpdateCache[key] = cache
+		exchangeRateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q exchangeRateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for exchange_rate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for exchange_rate")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ExchangeRateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), exchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"exchange_rate\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, exchangeRatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in exchangeRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all exchangeRate")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ExchangeRate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no exchange_rate provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(exchangeRateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	exchangeRateUpsertCacheMut.RLock()
+	cache, cached := exchangeRateUpsertCache[key]
+	exchangeRateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			exchangeRateAllColumns,
+			exchangeRateColumnsWithDefault,
+			exchangeRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			exchangeRateAllColumns,
+			exchangeRatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert exchange_rate, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(exchangeRatePrimaryKeyColumns))
+			copy(conflict, exchangeRatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"exchange_rate\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert exchange_rate")
+	}
+
+	if !cached {
+		exchangeRateUpsertCacheMut.Lock()
+		exchangeRateUpsertCache[key] = cache
+		exchangeRateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ExchangeRate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ExchangeRate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ExchangeRate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), exchangeRatePrimaryKeyMapping)
+	sql := "DELETE FROM \"exchange_rate\" WHERE \"currency\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from exchange_rate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for exchange_rate")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q exchangeRateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no exchangeRateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from exchange_rate")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for exchange_rate")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ExchangeRateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(exchangeRateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), exchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"exchange_rate\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, exchangeRatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from exchangeRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for exchange_rate")
+	}
+
+	if len(exchangeRateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ExchangeRate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindExchangeRate(ctx, exec, o.Currency)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ExchangeRateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ExchangeRateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), exchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"exchange_rate\".* FROM \"exchange_rate\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, exchangeRatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ExchangeRateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ExchangeRateExists checks if the ExchangeRate row exists.
+func ExchangeRateExists(ctx context.Context, exec boil.ContextExecutor, currency string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"exchange_rate\" where \"currency\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, currency)
+	}
+	row := exec.QueryRowContext(ctx, sql, currency)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if exchange_rate exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ExchangeRate row exists.
+func (o *ExchangeRate) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ExchangeRateExists(ctx, exec, o.Currency)
+}
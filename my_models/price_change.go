@@ -0,0 +1,1669 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PriceChange is an object representing the database table.
+type PriceChange struct {
+	ID                int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ProductID         int64       `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Amount            int64       `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Currency          string      `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	PreviousAmount    null.Int64  `boil:"previous_amount" json:"previous_amount,omitempty" toml:"previous_amount" yaml:"previous_amount,omitempty"`
+	PreviousCurrency  null.String `boil:"previous_currency" json:"previous_currency,omitempty" toml:"previous_currency" yaml:"previous_currency,omitempty"`
+	Reason            string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	ChangedByUserID   null.Int64  `boil:"changed_by_user_id" json:"changed_by_user_id,omitempty" toml:"changed_by_user_id" yaml:"changed_by_user_id,omitempty"`
+	ChangedByAPIKeyID null.Int64  `boil:"changed_by_api_key_id" json:"changed_by_api_key_id,omitempty" toml:"changed_by_api_key_id" yaml:"changed_by_api_key_id,omitempty"`
+	EffectiveAt       time.Time   `boil:"effective_at" json:"effective_at" toml:"effective_at" yaml:"effective_at"`
+	AppliedAt         null.Time   `boil:"applied_at" json:"applied_at,omitempty" toml:"applied_at" yaml:"applied_at,omitempty"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *priceChangeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L priceChangeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PriceChangeColumns = struct {
+	ID                string
+	ProductID         string
+	Amount            string
+	Currency          string
+	PreviousAmount    string
+	PreviousCurrency  string
+	Reason            string
+	ChangedByUserID   string
+	ChangedByAPIKeyID string
+	EffectiveAt       string
+	AppliedAt         string
+	CreatedAt         string
+}{
+	ID:                "id",
+	ProductID:         "product_id",
+	Amount:            "amount",
+	Currency:          "currency",
+	PreviousAmount:    "previous_amount",
+	PreviousCurrency:  "previous_currency",
+	Reason:            "reason",
+	ChangedByUserID:   "changed_by_user_id",
+	ChangedByAPIKeyID: "changed_by_api_key_id",
+	EffectiveAt:       "effective_at",
+	AppliedAt:         "applied_at",
+	CreatedAt:         "created_at",
+}
+
+var PriceChangeTableColumns = struct {
+	ID                string
+	ProductID         string
+	Amount            string
+	Currency          string
+	PreviousAmount    string
+	PreviousCurrency  string
+	Reason            string
+	ChangedByUserID   string
+	ChangedByAPIKeyID string
+	EffectiveAt       string
+	AppliedAt         string
+	CreatedAt         string
+}{
+	ID:                "price_change.id",
+	ProductID:         "price_change.product_id",
+	Amount:            "price_change.amount",
+	Currency:          "price_change.currency",
+	PreviousAmount:    "price_change.previous_amount",
+	PreviousCurrency:  "price_change.previous_currency",
+	Reason:            "price_change.reason",
+	ChangedByUserID:   "price_change.changed_by_user_id",
+	ChangedByAPIKeyID: "price_change.changed_by_api_key_id",
+	EffectiveAt:       "price_change.effective_at",
+	AppliedAt:         "price_change.applied_at",
+	CreatedAt:         "price_change.created_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var PriceChangeWhere = struct {
+	ID                whereHelperint64
+	ProductID         whereHelperint64
+	Amount            whereHelperint64
+	Currency          whereHelperstring
+	PreviousAmount    whereHelpernull_Int64
+	PreviousCurrency  whereHelpernull_String
+	Reason            whereHelperstring
+	ChangedByUserID   whereHelpernull_Int64
+	ChangedByAPIKeyID whereHelpernull_Int64
+	EffectiveAt       whereHelpertime_Time
+	AppliedAt         whereHelpernull_Time
+	CreatedAt         whereHelpertime_Time
+}{
+	ID:                whereHelperint64{field: "\"price_change\".\"id\""},
+	ProductID:         whereHelperint64{field: "\"price_change\".\"product_id\""},
+	Amount:            whereHelperint64{field: "\"price_change\".\"amount\""},
+	Currency:          whereHelperstring{field: "\"price_change\".\"currency\""},
+	PreviousAmount:    whereHelpernull_Int64{field: "\"price_change\".\"previous_amount\""},
+	PreviousCurrency:  whereHelpernull_String{field: "\"price_change\".\"previous_currency\""},
+	Reason:            whereHelperstring{field: "\"price_change\".\"reason\""},
+	ChangedByUserID:   whereHelpernull_Int64{field: "\"price_change\".\"changed_by_user_id\""},
+	ChangedByAPIKeyID: whereHelpernull_Int64{field: "\"price_change\".\"changed_by_api_key_id\""},
+	EffectiveAt:       whereHelpertime_Time{field: "\"price_change\".\"effective_at\""},
+	AppliedAt:         whereHelpernull_Time{field: "\"price_change\".\"applied_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"price_change\".\"created_at\""},
+}
+
+// PriceChangeRels is where relationship names are stored.
+var PriceChangeRels = struct {
+	Product         string
+	ChangedByUser   string
+	ChangedByAPIKey string
+}{
+	Product:         "Product",
+	ChangedByUser:   "ChangedByUser",
+	ChangedByAPIKey: "ChangedByAPIKey",
+}
+
+// priceChangeR is where relationships are stored.
+type priceChangeR struct {
+	Product         *Product `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+	ChangedByUser   *User    `boil:"ChangedByUser" json:"ChangedByUser" toml:"ChangedByUser" yaml:"ChangedByUser"`
+	ChangedByAPIKey *APIKey  `boil:"ChangedByAPIKey" json:"ChangedByAPIKey" toml:"ChangedByAPIKey" yaml:"ChangedByAPIKey"`
+}
+
+// NewStruct creates a new relationship struct
+func (*priceChangeR) NewStruct() *priceChangeR {
+	return &priceChangeR{}
+}
+
+func (r *priceChangeR) GetProduct() *Product {
+	if r == nil {
+		return nil
+	}
+	return r.Product
+}
+
+func (r *priceChangeR) GetChangedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ChangedByUser
+}
+
+func (r *priceChangeR) GetChangedByAPIKey() *APIKey {
+	if r == nil {
+		return nil
+	}
+	return r.ChangedByAPIKey
+}
+
+// priceChangeL is where Load methods for each relationship are stored.
+type priceChangeL struct{}
+
+var (
+	priceChangeAllColumns            = []string{"id", "product_id", "amount", "currency", "previous_amount", "previous_currency", "reason", "changed_by_user_id", "changed_by_api_key_id", "effective_at", "applied_at", "created_at"}
+	priceChangeColumnsWithoutDefault = []string{"id", "product_id", "amount", "currency", "effective_at", "created_at"}
+	priceChangeColumnsWithDefault    = []string{"previous_amount", "previous_currency", "reason", "changed_by_user_id", "changed_by_api_key_id", "applied_at"}
+	priceChangePrimaryKeyColumns     = []string{"id"}
+	priceChangeGeneratedColumns      = []string{}
+)
+
+type (
+	// PriceChangeSlice is an alias for a slice of pointers to PriceChange.
+	// This should almost always be used instead of []PriceChange.
+	PriceChangeSlice []*PriceChange
+	// PriceChangeHook is the signature for custom PriceChange hook methods
+	PriceChangeHook func(context.Context, boil.ContextExecutor, *PriceChange) error
+
+	priceChangeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	priceChangeType                 = reflect.TypeOf(&PriceChange{})
+	priceChangeMapping              = queries.MakeStructMapping(priceChangeType)
+	priceChangePrimaryKeyMapping, _ = queries.BindMapping(priceChangeType, priceChangeMapping, priceChangePrimaryKeyColumns)
+	priceChangeInsertCacheMut       sync.RWMutex
+	priceChangeInsertCache          = make(map[string]insertCache)
+	priceChangeUpdateCacheMut       sync.RWMutex
+	priceChangeUpdateCache          = make(map[string]updateCache)
+	priceChangeUpsertCacheMut       sync.RWMutex
+	priceChangeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var priceChangeAfterSelectHooks []PriceChangeHook
+
+var priceChangeBeforeInsertHooks []PriceChangeHook
+var priceChangeAfterInsertHooks []PriceChangeHook
+
+var priceChangeBeforeUpdateHooks []PriceChangeHook
+var priceChangeAfterUpdateHooks []PriceChangeHook
+
+var priceChangeBeforeDeleteHooks []PriceChangeHook
+var priceChangeAfterDeleteHooks []PriceChangeHook
+
+var priceChangeBeforeUpsertHooks []PriceChangeHook
+var priceChangeAfterUpsertHooks []PriceChangeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PriceChange) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PriceChange) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PriceChange) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PriceChange) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PriceChange) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PriceChange) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PriceChange) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PriceChange) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PriceChange) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceChangeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPriceChangeHook registers your hook function for all future operations.
+func AddPriceChangeHook(hookPoint boil.HookPoint, priceChangeHook PriceChangeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		priceChangeAfterSelectHooks = append(priceChangeAfterSelectHooks, priceChangeHook)
+	case boil.BeforeInsertHook:
+		priceChangeBeforeInsertHooks = append(priceChangeBeforeInsertHooks, priceChangeHook)
+	case boil.AfterInsertHook:
+		priceChangeAfterInsertHooks = append(priceChangeAfterInsertHooks, priceChangeHook)
+	case boil.BeforeUpdateHook:
+		priceChangeBeforeUpdateHooks = append(priceChangeBeforeUpdateHooks, priceChangeHook)
+	case boil.AfterUpdateHook:
+		priceChangeAfterUpdateHooks = append(priceChangeAfterUpdateHooks, priceChangeHook)
+	case boil.BeforeDeleteHook:
+		priceChangeBeforeDeleteHooks = append(priceChangeBeforeDeleteHooks, priceChangeHook)
+	case boil.AfterDeleteHook:
+		priceChangeAfterDeleteHooks = append(priceChangeAfterDeleteHooks, priceChangeHook)
+	case boil.BeforeUpsertHook:
+		priceChangeBeforeUpsertHooks = append(priceChangeBeforeUpsertHooks, priceChangeHook)
+	case boil.AfterUpsertHook:
+		priceChangeAfterUpsertHooks = append(priceChangeAfterUpsertHooks, priceChangeHook)
+	}
+}
+
+// One returns a single priceChange record from the query.
+func (q priceChangeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PriceChange, error) {
+	o := &PriceChange{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for price_change")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PriceChange records from the query.
+func (q priceChangeQuery) All(ctx context.Context, exec boil.ContextExecutor) (PriceChangeSlice, error) {
+	var o []*PriceChange
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PriceChange slice")
+	}
+
+	if len(priceChangeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PriceChange records in the query.
+func (q priceChangeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count price_change rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q priceChangeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if price_change exists")
+	}
+
+	return count > 0, nil
+}
+
+// Product pointed to by the foreign key.
+func (o *PriceChange) Product(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// ChangedByUser pointed to by the foreign key.
+func (o *PriceChange) ChangedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChangedByUserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ChangedByAPIKey pointed to by the foreign key.
+func (o *PriceChange) ChangedByAPIKey(mods ...qm.QueryMod) apiKeyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChangedByAPIKeyID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return APIKeys(queryMods...)
+}
+
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (priceChangeL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybePriceChange interface{}, mods queries.Applicator) error {
+	var slice []*PriceChange
+	var object *PriceChange
+
+	if singular {
+		var ok bool
+		object, ok = maybePriceChange.(*PriceChange)
+		if !ok {
+			object = new(PriceChange)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePriceChange)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePriceChange))
+			}
+		}
+	} else {
+		s, ok := maybePriceChange.(*[]*PriceChange)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePriceChange)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePriceChange))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &priceChangeR{}
+		}
+		args = append(args, object.ProductID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &priceChangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ProductID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ProductID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product`),
+		qm.WhereIn(`product.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for product")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.PriceChanges = append(foreign.R.PriceChanges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.PriceChanges = append(foreign.R.PriceChanges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (priceChangeL) LoadChangedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePriceChange interface{}, mods queries.Applicator) error {
+	var slice []*PriceChange
+	var object *PriceChange
+
+	if singular {
+		var ok bool
+		object, ok = maybePriceChange.(*PriceChange)
+		if !ok {
+			object = new(PriceChange)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePriceChange)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePriceChange))
+			}
+		}
+	} else {
+		s, ok := maybePriceChange.(*[]*PriceChange)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePriceChange)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePriceChange))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &priceChangeR{}
+		}
+		if !queries.IsNil(object.ChangedByUserID) {
+			args = append(args, object.ChangedByUserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &priceChangeR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ChangedByUserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ChangedByUserID) {
+				args = append(args, obj.ChangedByUserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ChangedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChangedByUserPriceChanges = append(foreign.R.ChangedByUserPriceChanges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ChangedByUserID, foreign.ID) {
+				local.R.ChangedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChangedByUserPriceChanges = append(foreign.R.ChangedByUserPriceChanges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedByAPIKey allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (priceChangeL) LoadChangedByAPIKey(ctx context.Context, e boil.ContextExecutor, singular bool, maybePriceChange interface{}, mods queries.Applicator) error {
+	var slice []*PriceChange
+	var object *PriceChange
+
+	if singular {
+		var ok bool
+		object, ok = maybePriceChange.(*PriceChange)
+		if !ok {
+			object = new(PriceChange)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePriceChange)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePriceChange))
+			}
+		}
+	} else {
+		s, ok := maybePriceChange.(*[]*PriceChange)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePriceChange)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePriceChange))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &priceChangeR{}
+		}
+		if !queries.IsNil(object.ChangedByAPIKeyID) {
+			args = append(args, object.ChangedByAPIKeyID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &priceChangeR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ChangedByAPIKeyID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ChangedByAPIKeyID) {
+				args = append(args, obj.ChangedByAPIKeyID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`api_key`),
+		qm.WhereIn(`api_key.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load APIKey")
+	}
+
+	var resultSlice []*APIKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice APIKey")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for api_key")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_key")
+	}
+
+	if len(apiKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ChangedByAPIKey = foreign
+		if foreign.R == nil {
+			foreign.R = &apiKeyR{}
+		}
+		foreign.R.ChangedByAPIKeyPriceChanges = append(foreign.R.ChangedByAPIKeyPriceChanges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ChangedByAPIKeyID, foreign.ID) {
+				local.R.ChangedByAPIKey = foreign
+				if foreign.R == nil {
+					foreign.R = &apiKeyR{}
+				}
+				foreign.R.ChangedByAPIKeyPriceChanges = append(foreign.R.ChangedByAPIKeyPriceChanges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProduct of the priceChange to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.PriceChanges.
+func (o *PriceChange) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"price_change\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, priceChangePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &priceChangeR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			PriceChanges: PriceChangeSlice{o},
+		}
+	} else {
+		related.R.PriceChanges = append(related.R.PriceChanges, o)
+	}
+
+	return nil
+}
+
+// SetChangedByUser of the priceChange to the related item.
+// Sets o.R.ChangedByUser to related.
+// Adds o to related.R.ChangedByUserPriceChanges.
+func (o *PriceChange) SetChangedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"price_change\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, priceChangePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ChangedByUserID, related.ID)
+	if o.R == nil {
+		o.R = &priceChangeR{
+			ChangedByUser: related,
+		}
+	} else {
+		o.R.ChangedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChangedByUserPriceChanges: PriceChangeSlice{o},
+		}
+	} else {
+		related.R.ChangedByUserPriceChanges = append(related.R.ChangedByUserPriceChanges, o)
+	}
+
+	return nil
+}
+
+// RemoveChangedByUser relationship.
+// Sets o.R.ChangedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PriceChange) RemoveChangedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ChangedByUserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("changed_by_user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ChangedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ChangedByUserPriceChanges {
+		if queries.Equal(o.ChangedByUserID, ri.ChangedByUserID) {
+			continue
+		}
+
+		ln := len(related.R.ChangedByUserPriceChanges)
+		if ln > 1 && i < ln-1 {
+			related.R.ChangedByUserPriceChanges[i] = related.R.ChangedByUserPriceChanges[ln-1]
+		}
+		related.R.ChangedByUserPriceChanges = related.R.ChangedByUserPriceChanges[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetChangedByAPIKey of the priceChange to the related item.
+// Sets o.R.ChangedByAPIKey to related.
+// Adds o to related.R.ChangedByAPIKeyPriceChanges.
+func (o *PriceChange) SetChangedByAPIKey(ctx context.Context, exec boil.ContextExecutor, insert bool, related *APIKey) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"price_change\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by_api_key_id"}),
+		strmangle.WhereClause("\"", "\"", 2, priceChangePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ChangedByAPIKeyID, related.ID)
+	if o.R == nil {
+		o.R = &priceChangeR{
+			ChangedByAPIKey: related,
+		}
+	} else {
+		o.R.ChangedByAPIKey = related
+	}
+
+	if related.R == nil {
+		related.R = &apiKeyR{
+			ChangedByAPIKeyPriceChanges: PriceChangeSlice{o},
+		}
+	} else {
+		related.R.ChangedByAPIKeyPriceChanges = append(related.R.ChangedByAPIKeyPriceChanges, o)
+	}
+
+	return nil
+}
+
+// RemoveChangedByAPIKey relationship.
+// Sets o.R.ChangedByAPIKey to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PriceChange) RemoveChangedByAPIKey(ctx context.Context, exec boil.ContextExecutor, related *APIKey) error {
+	var err error
+
+	queries.SetScanner(&o.ChangedByAPIKeyID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("changed_by_api_key_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ChangedByAPIKey = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ChangedByAPIKeyPriceChanges {
+		if queries.Equal(o.ChangedByAPIKeyID, ri.ChangedByAPIKeyID) {
+			continue
+		}
+
+		ln := len(related.R.ChangedByAPIKeyPriceChanges)
+		if ln > 1 && i < ln-1 {
+			related.R.ChangedByAPIKeyPriceChanges[i] = related.R.ChangedByAPIKeyPriceChanges[ln-1]
+		}
+		related.R.ChangedByAPIKeyPriceChanges = related.R.ChangedByAPIKeyPriceChanges[:ln-1]
+		break
+	}
+	return nil
+}
+
+// PriceChanges retrieves all the records using an executor.
+func PriceChanges(mods ...qm.QueryMod) priceChangeQuery {
+	mods = append(mods, qm.From("\"price_change\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"price_change\".*"})
+	}
+
+	return priceChangeQuery{q}
+}
+
+// FindPriceChange retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPriceChange(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*PriceChange, error) {
+	priceChangeObj := &PriceChange{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"price_change\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, priceChangeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from price_change")
+	}
+
+	if err = priceChangeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return priceChangeObj, err
+	}
+
+	return priceChangeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PriceChange) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no price_change provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(priceChangeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	priceChangeInsertCacheMut.RLock()
+	cache, cached := priceChangeInsertCache[key]
+	priceChangeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			priceChangeAllColumns,
+			priceChangeColumnsWithDefault,
+			priceChangeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(priceChangeType, priceChangeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(priceChangeType, priceChangeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"price_change\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"price_change\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into price_change")
+	}
+
+	if !cached {
+		priceChangeInsertCacheMut.Lock()
+		priceChangeInsertCache[key] = cache
+		priceChangeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PriceChange.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PriceChange) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	priceChangeUpdateCacheMut.RLock()
+	cache, cached := priceChangeUpdateCache[key]
+	priceChangeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			priceChangeAllColumns,
+			priceChangePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update price_change, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"price_change\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, priceChangePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(priceChangeType, priceChangeMapping, append(wl, priceChangePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update price_change row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for price_change")
+	}
+
+	if !cached {
+		priceChangeUpdateCacheMut.Lock()
+		priceChangeUpdateCache[key] = cache
+		priceChangeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q priceChangeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for price_change")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for price_change")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PriceChangeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"price_change\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, priceChangePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in priceChange slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all priceChange")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PriceChange) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no price_change provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(priceChangeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	priceChangeUpsertCacheMut.RLock()
+	cache, cached := priceChangeUpsertCache[key]
+	priceChangeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			priceChangeAllColumns,
+			priceChangeColumnsWithDefault,
+			priceChangeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			priceChangeAllColumns,
+			priceChangePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert price_change, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(priceChangePrimaryKeyColumns))
+			copy(conflict, priceChangePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"price_change\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(priceChangeType, priceChangeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(priceChangeType, priceChangeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert price_change")
+	}
+
+	if !cached {
+		priceChangeUpsertCacheMut.Lock()
+		priceChangeUpsertCache[key] = cache
+		priceChangeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PriceChange record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PriceChange) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PriceChange provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), priceChangePrimaryKeyMapping)
+	sql := "DELETE FROM \"price_change\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from price_change")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for price_change")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q priceChangeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no priceChangeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from price_change")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for price_change")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PriceChangeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(priceChangeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"price_change\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, priceChangePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from priceChange slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for price_change")
+	}
+
+	if len(priceChangeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PriceChange) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPriceChange(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PriceChangeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PriceChangeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"price_change\".* FROM \"price_change\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, priceChangePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PriceChangeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PriceChangeExists checks if the PriceChange row exists.
+func PriceChangeExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"price_change\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if price_change exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PriceChange row exists.
+func (o *PriceChange) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PriceChangeExists(ctx, exec, o.ID)
+}
@@ -24,191 +24,97 @@ import (
 
 // Product is an object representing the database table.
 type Product struct {
-	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Price     int       `boil:"price" json:"price" toml:"price" yaml:"price"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID           int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name         string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Price        int64       `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Currency     string      `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt    null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	SearchVector null.String `boil:"search_vector" json:"search_vector,omitempty" toml:"search_vector" yaml:"search_vector,omitempty"`
 
 	R *productR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L productL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ProductColumns = struct {
-	ID        string
-	Name      string
-	Price     string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
+	ID           string
+	Name         string
+	Price        string
+	Currency     string
+	CreatedAt    string
+	UpdatedAt    string
+	DeletedAt    string
+	SearchVector string
 }{
-	ID:        "id",
-	Name:      "name",
-	Price:     "price",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	DeletedAt: "deleted_at",
+	ID:           "id",
+	Name:         "name",
+	Price:        "price",
+	Currency:     "currency",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	DeletedAt:    "deleted_at",
+	SearchVector: "search_vector",
 }
 
 var ProductTableColumns = struct {
-	ID        string
-	Name      string
-	Price     string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
+	ID           string
+	Name         string
+	Price        string
+	Currency     string
+	CreatedAt    string
+	UpdatedAt    string
+	DeletedAt    string
+	SearchVector string
 }{
-	ID:        "product.id",
-	Name:      "product.name",
-	Price:     "product.price",
-	CreatedAt: "product.created_at",
-	UpdatedAt: "product.updated_at",
-	DeletedAt: "product.deleted_at",
+	ID:           "product.id",
+	Name:         "product.name",
+	Price:        "product.price",
+	Currency:     "product.currency",
+	CreatedAt:    "product.created_at",
+	UpdatedAt:    "product.updated_at",
+	DeletedAt:    "product.deleted_at",
+	SearchVector: "product.search_vector",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var ProductWhere = struct {
-	ID        whereHelperint64
-	Name      whereHelperstring
-	Price     whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-	DeletedAt whereHelpernull_Time
+	ID           whereHelperint64
+	Name         whereHelperstring
+	Price        whereHelperint64
+	Currency     whereHelperstring
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+	DeletedAt    whereHelpernull_Time
+	SearchVector whereHelpernull_String
 }{
-	ID:        whereHelperint64{field: "\"product\".\"id\""},
-	Name:      whereHelperstring{field: "\"product\".\"name\""},
-	Price:     whereHelperint{field: "\"product\".\"price\""},
-	CreatedAt: whereHelpertime_Time{field: "\"product\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"product\".\"updated_at\""},
-	DeletedAt: whereHelpernull_Time{field: "\"product\".\"deleted_at\""},
+	ID:           whereHelperint64{field: "\"product\".\"id\""},
+	Name:         whereHelperstring{field: "\"product\".\"name\""},
+	Price:        whereHelperint64{field: "\"product\".\"price\""},
+	Currency:     whereHelperstring{field: "\"product\".\"currency\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"product\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"product\".\"updated_at\""},
+	DeletedAt:    whereHelpernull_Time{field: "\"product\".\"deleted_at\""},
+	SearchVector: whereHelpernull_String{field: "\"product\".\"search_vector\""},
 }
 
 // ProductRels is where relationship names are stored.
 var ProductRels = struct {
-}{}
+	PriceChanges  string
+	Categories    string
+	ProductPrices string
+}{
+	PriceChanges:  "PriceChanges",
+	Categories:    "Categories",
+	ProductPrices: "ProductPrices",
+}
 
 // productR is where relationships are stored.
 type productR struct {
+	PriceChanges  PriceChangeSlice  `boil:"PriceChanges" json:"PriceChanges" toml:"PriceChanges" yaml:"PriceChanges"`
+	Categories    CategorySlice     `boil:"Categories" json:"Categories" toml:"Categories" yaml:"Categories"`
+	ProductPrices ProductPriceSlice `boil:"ProductPrices" json:"ProductPrices" toml:"ProductPrices" yaml:"ProductPrices"`
 }
 
 // NewStruct creates a new relationship struct
@@ -216,15 +122,36 @@ func (*productR) NewStruct() *productR {
 	return &productR{}
 }
 
+func (r *productR) GetPriceChanges() PriceChangeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PriceChanges
+}
+
+func (r *productR) GetCategories() CategorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Categories
+}
+
+func (r *productR) GetProductPrices() ProductPriceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductPrices
+}
+
 // productL is where Load methods for each relationship are stored.
 type productL struct{}
 
 var (
-	productAllColumns            = []string{"id", "name", "price", "created_at", "updated_at", "deleted_at"}
+	productAllColumns            = []string{"id", "name", "price", "currency", "created_at", "updated_at", "deleted_at", "search_vector"}
 	productColumnsWithoutDefault = []string{"id", "name", "price", "created_at", "updated_at"}
-	productColumnsWithDefault    = []string{"deleted_at"}
+	productColumnsWithDefault    = []string{"currency", "deleted_at", "search_vector"}
 	productPrimaryKeyColumns     = []string{"id"}
-	productGeneratedColumns      = []string{}
+	productGeneratedColumns      = []string{"search_vector"}
 )
 
 type (
@@ -505,6 +432,659 @@ func (q productQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
+// PriceChanges retrieves all the price_change's PriceChanges with an executor.
+func (o *Product) PriceChanges(mods ...qm.QueryMod) priceChangeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"price_change\".\"product_id\"=?", o.ID),
+	)
+
+	return PriceChanges(queryMods...)
+}
+
+// Categories retrieves all the category's Categories with an executor.
+func (o *Product) Categories(mods ...qm.QueryMod) categoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"product_category\" on \"category\".\"id\" = \"product_category\".\"category_id\""),
+		qm.Where("\"product_category\".\"product_id\"=?", o.ID),
+	)
+
+	return Categories(queryMods...)
+}
+
+// ProductPrices retrieves all the product_price's ProductPrices with an executor.
+func (o *Product) ProductPrices(mods ...qm.QueryMod) productPriceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_price\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductPrices(queryMods...)
+}
+
+// LoadPriceChanges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadPriceChanges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`price_change`),
+		qm.WhereIn(`price_change.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load price_change")
+	}
+
+	var resultSlice []*PriceChange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice price_change")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on price_change")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for price_change")
+	}
+
+	if len(priceChangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PriceChanges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &priceChangeR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.PriceChanges = append(local.R.PriceChanges, foreign)
+				if foreign.R == nil {
+					foreign.R = &priceChangeR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCategories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadCategories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"category\".\"id\", \"category\".\"parent_id\", \"category\".\"name\", \"category\".\"created_at\", \"category\".\"updated_at\", \"a\".\"product_id\""),
+		qm.From("\"category\""),
+		qm.InnerJoin("\"product_category\" as \"a\" on \"category\".\"id\" = \"a\".\"category_id\""),
+		qm.WhereIn("\"a\".\"product_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load category")
+	}
+
+	var resultSlice []*Category
+
+	var localJoinCols []int64
+	for results.Next() {
+		one := new(Category)
+		var localJoinCol int64
+
+		err = results.Scan(&one.ID, &one.ParentID, &one.Name, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for category")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice category")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on category")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for category")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Categories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &categoryR{}
+			}
+			foreign.R.Products = append(foreign.R.Products, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Categories = append(local.R.Categories, foreign)
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.Products = append(foreign.R.Products, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductPrices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductPrices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_price`),
+		qm.WhereIn(`product_price.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_price")
+	}
+
+	var resultSlice []*ProductPrice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_price")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_price")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_price")
+	}
+
+	if len(productPriceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductPrices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productPriceR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductPrices = append(local.R.ProductPrices, foreign)
+				if foreign.R == nil {
+					foreign.R = &productPriceR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPriceChanges adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.PriceChanges.
+// Sets related.R.Product appropriately.
+func (o *Product) AddPriceChanges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PriceChange) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"price_change\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, priceChangePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			PriceChanges: related,
+		}
+	} else {
+		o.R.PriceChanges = append(o.R.PriceChanges, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &priceChangeR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddCategories adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.Categories.
+// Sets related.R.Products appropriately.
+func (o *Product) AddCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"product_category\" (\"product_id\", \"category_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &productR{
+			Categories: related,
+		}
+	} else {
+		o.R.Categories = append(o.R.Categories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &categoryR{
+				Products: ProductSlice{o},
+			}
+		} else {
+			rel.R.Products = append(rel.R.Products, o)
+		}
+	}
+	return nil
+}
+
+// SetCategories removes all previously related items of the
+// product replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Products's Categories accordingly.
+// Replaces o.R.Categories with related.
+// Sets related.R.Products's Categories accordingly.
+func (o *Product) SetCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	query := "delete from \"product_category\" where \"product_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeCategoriesFromProductsSlice(o, related)
+	if o.R != nil {
+		o.R.Categories = nil
+	}
+
+	return o.AddCategories(ctx, exec, insert, related...)
+}
+
+// RemoveCategories relationships from objects passed in.
+// Removes related items from R.Categories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Products.
+func (o *Product) RemoveCategories(ctx context.Context, exec boil.ContextExecutor, related ...*Category) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"product_category\" where \"product_id\" = $1 and \"category_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeCategoriesFromProductsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Categories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Categories)
+			if ln > 1 && i < ln-1 {
+				o.R.Categories[i] = o.R.Categories[ln-1]
+			}
+			o.R.Categories = o.R.Categories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeCategoriesFromProductsSlice(o *Product, related []*Category) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Products {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Products)
+			if ln > 1 && i < ln-1 {
+				rel.R.Products[i] = rel.R.Products[ln-1]
+			}
+			rel.R.Products = rel.R.Products[:ln-1]
+			break
+		}
+	}
+}
+
+// AddProductPrices adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductPrices.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductPrices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductPrice) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_price\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productPricePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			ProductPrices: related,
+		}
+	} else {
+		o.R.ProductPrices = append(o.R.ProductPrices, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productPriceR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
 // Products retrieves all the records using an executor.
 func Products(mods ...qm.QueryMod) productQuery {
 	mods = append(mods, qm.From("\"product\""))
@@ -583,6 +1163,7 @@ func (o *Product) Insert(ctx context.Context, exec boil.ContextExecutor, columns
 			productColumnsWithoutDefault,
 			nzDefaults,
 		)
+		wl = strmangle.SetComplement(wl, productGeneratedColumns)
 
 		cache.valueMapping, err = queries.BindMapping(productType, productMapping, wl)
 		if err != nil {
@@ -659,6 +1240,7 @@ func (o *Product) Update(ctx context.Context, exec boil.ContextExecutor, columns
 			productAllColumns,
 			productPrimaryKeyColumns,
 		)
+		wl = strmangle.SetComplement(wl, productGeneratedColumns)
 
 		if !columns.IsWhitelist() {
 			wl = strmangle.SetComplement(wl, []string{"created_at"})
@@ -837,6 +1419,9 @@ func (o *Product) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 			productPrimaryKeyColumns,
 		)
 
+		insert = strmangle.SetComplement(insert, productGeneratedColumns)
+		update = strmangle.SetComplement(update, productGeneratedColumns)
+
 		if updateOnConflict && len(update) == 0 {
 			return errors.New("models: unable to upsert product, could not build update column list")
 		}
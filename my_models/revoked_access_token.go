@@ -0,0 +1,916 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RevokedAccessToken is an object representing the database table.
+type RevokedAccessToken struct {
+	Jti       string    `boil:"jti" json:"jti" toml:"jti" yaml:"jti"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *revokedAccessTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L revokedAccessTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RevokedAccessTokenColumns = struct {
+	Jti       string
+	ExpiresAt string
+	CreatedAt string
+}{
+	Jti:       "jti",
+	ExpiresAt: "expires_at",
+	CreatedAt: "created_at",
+}
+
+var RevokedAccessTokenTableColumns = struct {
+	Jti       string
+	ExpiresAt string
+	CreatedAt string
+}{
+	Jti:       "revoked_access_token.jti",
+	ExpiresAt: "revoked_access_token.expires_at",
+	CreatedAt: "revoked_access_token.created_at",
+}
+
+// Generated where
+
+var RevokedAccessTokenWhere = struct {
+	Jti       whereHelperstring
+	ExpiresAt whereHelpertime_Time
+	CreatedAt whereHelpertime_Time
+}{
+	Jti:       whereHelperstring{field: "\"revoked_access_token\".\"jti\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"revoked_access_token\".\"expires_at\""},
+	CreatedAt: whereHelpertime_Time{field: "\"revoked_access_token\".\"created_at\""},
+}
+
+// RevokedAccessTokenRels is where relationship names are stored.
+var RevokedAccessTokenRels = struct {
+}{}
+
+// revokedAccessTokenR is where relationships are stored.
+type revokedAccessTokenR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*revokedAccessTokenR) NewStruct() *revokedAccessTokenR {
+	return &revokedAccessTokenR{}
+}
+
+// revokedAccessTokenL is where Load methods for each relationship are stored.
+type revokedAccessTokenL struct{}
+
+var (
+	revokedAccessTokenAllColumns            = []string{"jti", "expires_at", "created_at"}
+	revokedAccessTokenColumnsWithoutDefault = []string{"jti", "expires_at", "created_at"}
+	revokedAccessTokenColumnsWithDefault    = []string{}
+	revokedAccessTokenPrimaryKeyColumns     = []string{"jti"}
+	revokedAccessTokenGeneratedColumns      = []string{}
+)
+
+type (
+	// RevokedAccessTokenSlice is an alias for a slice of pointers to RevokedAccessToken.
+	// This should almost always be used instead of []RevokedAccessToken.
+	RevokedAccessTokenSlice []*RevokedAccessToken
+	// RevokedAccessTokenHook is the signature for custom RevokedAccessToken hook methods
+	RevokedAccessTokenHook func(context.Context, boil.ContextExecutor, *RevokedAccessToken) error
+
+	revokedAccessTokenQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	revokedAccessTokenType                 = reflect.TypeOf(&RevokedAccessToken{})
+	revokedAccessTokenMapping              = queries.MakeStructMapping(revokedAccessTokenType)
+	revokedAccessTokenPrimaryKeyMapping, _ = queries.BindMapping(revokedAccessTokenType, revokedAccessTokenMapping, revokedAccessTokenPrimaryKeyColumns)
+	revokedAccessTokenInsertCacheMut       sync.RWMutex
+	revokedAccessTokenInsertCache          = make(map[string]insertCache)
+	revokedAccessTokenUpdateCacheMut       sync.RWMutex
+	revokedAccessTokenUpdateCache          = make(map[string]updateCache)
+	revokedAccessTokenUpsertCacheMut       sync.RWMutex
+	revokedAccessTokenUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var revokedAccessTokenAfterSelectHooks []RevokedAccessTokenHook
+
+var revokedAccessTokenBeforeInsertHooks []RevokedAccessTokenHook
+var revokedAccessTokenAfterInsertHooks []RevokedAccessTokenHook
+
+var revokedAccessTokenBeforeUpdateHooks []RevokedAccessTokenHook
+var revokedAccessTokenAfterUpdateHooks []RevokedAccessTokenHook
+
+var revokedAccessTokenBeforeDeleteHooks []RevokedAccessTokenHook
+var revokedAccessTokenAfterDeleteHooks []RevokedAccessTokenHook
+
+var revokedAccessTokenBeforeUpsertHooks []RevokedAccessTokenHook
+var revokedAccessTokenAfterUpsertHooks []RevokedAccessTokenHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RevokedAccessToken) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RevokedAccessToken) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RevokedAccessToken) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RevokedAccessToken) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RevokedAccessToken) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RevokedAccessToken) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RevokedAccessToken) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RevokedAccessToken) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RevokedAccessToken) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedAccessTokenAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRevokedAccessTokenHook registers your hook function for all future operations.
+func AddRevokedAccessTokenHook(hookPoint boil.HookPoint, revokedAccessTokenHook RevokedAccessTokenHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		revokedAccessTokenAfterSelectHooks = append(revokedAccessTokenAfterSelectHooks, revokedAccessTokenHook)
+	case boil.BeforeInsertHook:
+		revokedAccessTokenBeforeInsertHooks = append(revokedAccessTokenBeforeInsertHooks, revokedAccessTokenHook)
+	case boil.AfterInsertHook:
+		revokedAccessTokenAfterInsertHooks = append(revokedAccessTokenAfterInsertHooks, revokedAccessTokenHook)
+	case boil.BeforeUpdateHook:
+		revokedAccessTokenBeforeUpdateHooks = append(revokedAccessTokenBeforeUpdateHooks, revokedAccessTokenHook)
+	case boil.AfterUpdateHook:
+		revokedAccessTokenAfterUpdateHooks = append(revokedAccessTokenAfterUpdateHooks, revokedAccessTokenHook)
+	case boil.BeforeDeleteHook:
+		revokedAccessTokenBeforeDeleteHooks = append(revokedAccessTokenBeforeDeleteHooks, revokedAccessTokenHook)
+	case boil.AfterDeleteHook:
+		revokedAccessTokenAfterDeleteHooks = append(revokedAccessTokenAfterDeleteHooks, revokedAccessTokenHook)
+	case boil.BeforeUpsertHook:
+		revokedAccessTokenBeforeUpsertHooks = append(revokedAccessTokenBeforeUpsertHooks, revokedAccessTokenHook)
+	case boil.AfterUpsertHook:
+		revokedAccessTokenAfterUpsertHooks = append(revokedAccessTokenAfterUpsertHooks, revokedAccessTokenHook)
+	}
+}
+
+// One returns a single revokedAccessToken record from the query.
+func (q revokedAccessTokenQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RevokedAccessToken, error) {
+	o := &RevokedAccessToken{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for revoked_access_token")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RevokedAccessToken records from the query.
+func (q revokedAccessTokenQuery) All(ctx context.Context, exec boil.ContextExecutor) (RevokedAccessTokenSlice, error) {
+	var o []*RevokedAccessToken
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RevokedAccessToken slice")
+	}
+
+	if len(revokedAccessTokenAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RevokedAccessToken records in the query.
+func (q revokedAccessTokenQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count revoked_access_token rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q revokedAccessTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if revoked_access_token exists")
+	}
+
+	return count > 0, nil
+}
+
+// RevokedAccessTokens retrieves all the records using an executor.
+func RevokedAccessTokens(mods ...qm.QueryMod) revokedAccessTokenQuery {
+	mods = append(mods, qm.From("\"revoked_access_token\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"revoked_access_token\".*"})
+	}
+
+	return revokedAccessTokenQuery{q}
+}
+
+// FindRevokedAccessToken retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRevokedAccessToken(ctx context.Context, exec boil.ContextExecutor, jti string, selectCols ...string) (*RevokedAccessToken, error) {
+	revokedAccessTokenObj := &RevokedAccessToken{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"revoked_access_token\" where \"jti\"=$1", sel,
+	)
+
+	q := queries.Raw(query, jti)
+
+	err := q.Bind(ctx, exec, revokedAccessTokenObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from revoked_access_token")
+	}
+
+	if err = revokedAccessTokenObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return revokedAccessTokenObj, err
+	}
+
+	return revokedAccessTokenObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RevokedAccessToken) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no revoked_access_token provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(revokedAccessTokenColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	revokedAccessTokenInsertCacheMut.RLock()
+	cache, cached := revokedAccessTokenInsertCache[key]
+	revokedAccessTokenInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			revokedAccessTokenAllColumns,
+			revokedAccessTokenColumnsWithDefault,
+			revokedAccessTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(revokedAccessTokenType, revokedAccessTokenMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(revokedAccessTokenType, revokedAccessTokenMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"revoked_access_token\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"revoked_access_token\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into revoked_access_token")
+	}
+
+	if !cached {
+		revokedAccessTokenInsertCacheMut.Lock()
+		revokedAccessTokenInsertCache[key] = cache
+		revokedAccessTokenInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RevokedAccessToken.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RevokedAccessToken) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	revokedAccessTokenUpdateCacheMut.RLock()
+	cache, cached := revokedAccessTokenUpdateCache[key]
+	revokedAccessTokenUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			revokedAccessTokenAllColumns,
+			revokedAccessTokenPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update revoked_access_token, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"revoked_access_token\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, revokedAccessTokenPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(revokedAccessTokenType, revokedAccessTokenMapping, append(wl, revokedAccessTokenPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update revoked_access_token row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for revoked_access_token")
+	}
+
+	if !cached {
+		revokedAccessTokenUpdateCacheMut.Lock()
+		revokedAccessTokenUpdateCache[key] = cache
+		revokedAccessTokenUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q revokedAccessTokenQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for revoked_access_token")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for revoked_access_token")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RevokedAccessTokenSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), revokedAccessTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"revoked_access_token\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, revokedAccessTokenPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in revokedAccessToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all revokedAccessToken")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RevokedAccessToken) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no revoked_access_token provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(revokedAccessTokenColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	revokedAccessTokenUpsertCacheMut.RLock()
+	cache, cached := revokedAccessTokenUpsertCache[key]
+	revokedAccessTokenUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			revokedAccessTokenAllColumns,
+			revokedAccessTokenColumnsWithDefault,
+			revokedAccessTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			revokedAccessTokenAllColumns,
+			revokedAccessTokenPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert revoked_access_token, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(revokedAccessTokenPrimaryKeyColumns))
+			copy(conflict, revokedAccessTokenPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"revoked_access_token\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(revokedAccessTokenType, revokedAccessTokenMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(revokedAccessTokenType, revokedAccessTokenMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert revoked_access_token")
+	}
+
+	if !cached {
+		revokedAccessTokenUpsertCacheMut.Lock()
+		revokedAccessTokenUpsertCache[key] = cache
+		revokedAccessTokenUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RevokedAccessToken record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RevokedAccessToken) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RevokedAccessToken provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), revokedAccessTokenPrimaryKeyMapping)
+	sql := "DELETE FROM \"revoked_access_token\" WHERE \"jti\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from revoked_access_token")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for revoked_access_token")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q revokedAccessTokenQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no revokedAccessTokenQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from revoked_access_token")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for revoked_access_token")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RevokedAccessTokenSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(revokedAccessTokenBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), revokedAccessTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"revoked_access_token\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, revokedAccessTokenPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from revokedAccessToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for revoked_access_token")
+	}
+
+	if len(revokedAccessTokenAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RevokedAccessToken) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRevokedAccessToken(ctx, exec, o.Jti)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RevokedAccessTokenSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RevokedAccessTokenSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), revokedAccessTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"revoked_access_token\".* FROM \"revoked_access_token\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, revokedAccessTokenPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RevokedAccessTokenSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RevokedAccessTokenExists checks if the RevokedAccessToken row exists.
+func RevokedAccessTokenExists(ctx context.Context, exec boil.ContextExecutor, jti string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"revoked_access_token\" where \"jti\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, jti)
+	}
+	row := exec.QueryRowContext(ctx, sql, jti)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if revoked_access_token exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RevokedAccessToken row exists.
+func (o *RevokedAccessToken) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RevokedAccessTokenExists(ctx, exec, o.Jti)
+}
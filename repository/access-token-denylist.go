@@ -0,0 +1,29 @@
+package repository
+
+import (
+	models "chi-demo/my_models"
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DenyAccessToken adds an access token id to the denylist. Entries of
+// tokens that have expired since are dropped along the way.
+func (i TokenRepositoryImpl) DenyAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if _, err := models.RevokedAccessTokens(
+		models.RevokedAccessTokenWhere.ExpiresAt.LT(time.Now()),
+	).DeleteAll(ctx, i.db); err != nil {
+		return err
+	}
+
+	t := models.RevokedAccessToken{
+		Jti:       jti,
+		ExpiresAt: expiresAt,
+	}
+	return t.Upsert(ctx, i.db, false, []string{models.RevokedAccessTokenColumns.Jti}, boil.None(), boil.Infer())
+}
+
+func (i TokenRepositoryImpl) IsAccessTokenDenied(ctx context.Context, jti string) (bool, error) {
+	return models.RevokedAccessTokenExists(ctx, i.db, jti)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sony/sonyflake"
+)
+
+type APIKeyRepositoryImpl struct {
+	db    db.ContextExecutor
+	idsnf *sonyflake.Sonyflake
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key model.APIKey) (model.APIKey, error)
+	GetAll(ctx context.Context) ([]model.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (model.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+}
+
+func NewAPIKeyRepository(db db.ContextExecutor) (APIKeyRepository, error) {
+	flake, err := newIDGenerator()
+	if err != nil {
+		return nil, err
+	}
+
+	return APIKeyRepositoryImpl{
+		db:    db,
+		idsnf: flake,
+	}, nil
+}
+
+func toAPIKey(k *models.APIKey) model.APIKey {
+	scopes := make([]model.Scope, len(k.Scopes))
+	for i, s := range k.Scopes {
+		scopes[i] = model.Scope(s)
+	}
+
+	return model.APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		KeyHash:   k.KeyHash,
+		Scopes:    scopes,
+		CreatedBy: k.CreatedBy,
+		RevokedAt: k.RevokedAt.Time,
+		CreatedAt: k.CreatedAt,
+		UpdatedAt: k.UpdatedAt,
+	}
+}
+
+// apiKeyErr turns the database errors of the API key queries into domain errors.
+func apiKeyErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperr.NotFound("API key", err)
+	}
+	return err
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	"chi-demo/repository/testdata"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyImpl_Create(t *testing.T) {
+	type args struct {
+		expDBFailed bool
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {},
+		"error: db failed": {
+			expDBFailed: true,
+			expErr:      errors.New("models: unable to insert into api_key: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewAPIKeyRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewAPIKeyRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/api_keys.sql")
+
+				// When
+				result, err := repo.Create(ctx, model.APIKey{
+					Name:      "new",
+					KeyHash:   "hash-new",
+					Scopes:    []model.Scope{model.ScopeProductsRead, model.ScopeProductsWrite},
+					CreatedBy: 1,
+				})
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.NotZero(t, result.ID)
+					require.NotZero(t, result.CreatedAt)
+					require.Equal(t, []model.Scope{model.ScopeProductsRead, model.ScopeProductsWrite}, result.Scopes)
+					require.Zero(t, result.RevokedAt)
+				}
+			})
+		})
+	}
+}
+
+func TestAPIKeyImpl_GetAll(t *testing.T) {
+	type args struct {
+		expDBFailed bool
+		expIDs      []int64
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			expIDs: []int64{2, 1},
+		},
+		"error: db failed": {
+			expDBFailed: true,
+			expErr:      errors.New("models: failed to assign all query results to APIKey slice: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewAPIKeyRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewAPIKeyRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/api_keys.sql")
+
+				// When
+				result, err := repo.GetAll(ctx)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					ids := make([]int64, 0, len(result))
+					for _, key := range result {
+						ids = append(ids, key.ID)
+					}
+					require.Equal(t, tc.expIDs, ids)
+				}
+			})
+		})
+	}
+}
+
+func TestAPIKeyImpl_GetByHash(t *testing.T) {
+	type args struct {
+		givenHash   string
+		expDBFailed bool
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenHash: "hash-2",
+		},
+		"error: not found": {
+			givenHash: "unknown",
+			expErr:    apperr.NotFound("API key", sql.ErrNoRows),
+		},
+		"error: db failed": {
+			givenHash:   "hash-2",
+			expDBFailed: true,
+			expErr:      errors.New("models: failed to execute a one query for api_key: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewAPIKeyRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewAPIKeyRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/api_keys.sql")
+
+				// When
+				result, err := repo.GetByHash(ctx, tc.givenHash)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, int64(2), result.ID)
+					require.Equal(t, "batch", result.Name)
+					require.Equal(t, []model.Scope{model.ScopeProductsRead, model.ScopeProductsWrite}, result.Scopes)
+				}
+			})
+		})
+	}
+}
+
+func TestAPIKeyImpl_Revoke(t *testing.T) {
+	type args struct {
+		givenID     int64
+		expDBFailed bool
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID: 2,
+		},
+		"error: already revoked": {
+			givenID: 1,
+			expErr:  apperr.NotFound("API key", sql.ErrNoRows),
+		},
+		"error: not found": {
+			givenID: 3,
+			expErr:  apperr.NotFound("API key", sql.ErrNoRows),
+		},
+		"error: db failed": {
+			givenID:     2,
+			expDBFailed: true,
+			expErr:      errors.New("models: unable to update all for api_key: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewAPIKeyRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewAPIKeyRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/api_keys.sql")
+
+				// When
+				err = repo.Revoke(ctx, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					key, err := repo.GetByHash(ctx, "hash-2")
+					require.NoError(t, err)
+					require.NotZero(t, key.RevokedAt)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"chi-demo/tracing"
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// applyPriceChangeQuery sets the product price and marks the change applied
+// in a single statement. The locked change is rechecked for applied_at, so
+// concurrent schedulers apply it once.
+const applyPriceChangeQuery = `WITH change AS (
+	SELECT "id", "product_id", "amount", "currency" FROM "price_change"
+	WHERE "id" = $1 AND "applied_at" IS NULL
+	FOR UPDATE
+), previous AS (
+	SELECT "product"."id", "product"."price", "product"."currency"
+	FROM "product" JOIN change ON "product"."id" = change."product_id"
+	WHERE "product"."deleted_at" IS NULL
+	FOR UPDATE OF "product"
+), updated AS (
+	UPDATE "product" SET "price" = change."amount", "currency" = change."currency", "updated_at" = $2
+	FROM change, previous WHERE "product"."id" = previous."id"
+)
+UPDATE "price_change" SET "applied_at" = $2, "previous_amount" = previous."price", "previous_currency" = previous."currency"
+FROM previous WHERE "price_change"."id" = $1`
+
+// Apply makes a pending price change the product price, it returns false
+// when the change was already applied or its product is tombstoned.
+func (i PriceChangeRepositoryImpl) Apply(ctx context.Context, id int64, at time.Time) (_ bool, err error) {
+	ctx, span := tracer.Start(ctx, "PriceChangeRepository.Apply")
+	defer tracing.End(span, &err)
+
+	res, err := queries.Raw(applyPriceChangeQuery, id, at).ExecContext(ctx, i.db)
+	if err != nil {
+		return false, err
+	}
+	rowsAff, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAff > 0, nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"database/sql"
+)
+
+// Cancel removes a scheduled price change, applied ones belong to the
+// history and are not found.
+func (i PriceChangeRepositoryImpl) Cancel(ctx context.Context, productID, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "PriceChangeRepository.Cancel")
+	defer tracing.End(span, &err)
+
+	rowsAff, err := models.PriceChanges(
+		models.PriceChangeWhere.ID.EQ(id),
+		models.PriceChangeWhere.ProductID.EQ(productID),
+		models.PriceChangeWhere.AppliedAt.IsNull(),
+	).DeleteAll(ctx, i.db)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return priceChangeErr(sql.ErrNoRows)
+	}
+	return nil
+}
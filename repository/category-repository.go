@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/sony/sonyflake"
+)
+
+type CategoryRepositoryImpl struct {
+	db    db.ContextExecutor
+	idsnf *sonyflake.Sonyflake
+}
+
+type CategoryRepository interface {
+	GetAll(ctx context.Context) ([]model.Category, error)
+	GetOne(ctx context.Context, id int64) (model.Category, error)
+	Create(ctx context.Context, category model.Category) (model.Category, error)
+	Update(ctx context.Context, category model.Category) (model.Category, error)
+	Delete(ctx context.Context, id int64) error
+	GetByProduct(ctx context.Context, productID int64) ([]model.Category, error)
+	SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64) error
+}
+
+func NewCategoryRepository(db db.ContextExecutor) (CategoryRepository, error) {
+	flake, err := newIDGenerator()
+	if err != nil {
+		return nil, err
+	}
+
+	return CategoryRepositoryImpl{
+		db:    db,
+		idsnf: flake,
+	}, nil
+}
+
+func toCategory(c *models.Category) model.Category {
+	return model.Category{
+		ID:        c.ID,
+		ParentID:  c.ParentID.Int64,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toCategories(categories models.CategorySlice) []model.Category {
+	result := make([]model.Category, len(categories))
+	for i, c := range categories {
+		result[i] = toCategory(c)
+	}
+	return result
+}
+
+// categoryErr turns the database errors of the category queries into domain
+// errors.
+func categoryErr(err error) error {
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return apperr.NotFound("category", err)
+	case errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation && pqErr.Constraint == "category_parent_id_fkey":
+		return apperr.Validation(apperr.FieldViolation{Field: "parent_id", Message: "does not exist"})
+	case errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation && pqErr.Constraint == "product_category_category_id_fkey":
+		return apperr.Validation(apperr.FieldViolation{Field: "category_ids", Message: "contains an unknown category"})
+	case errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation && pqErr.Constraint == "product_category_product_id_fkey":
+		return apperr.NotFound("product", err)
+	case errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "category_parent_name_idx":
+		return apperr.Conflict("category name already exists under this parent", err)
+	case errors.As(err, &pqErr) && pqErr.Code == checkViolation && pqErr.Constraint == "category_parent_check":
+		return apperr.Validation(apperr.FieldViolation{Field: "parent_id", Message: "must not be the category itself"})
+	case errors.As(err, &pqErr) && pqErr.Code == checkViolation && pqErr.Constraint == "category_cycle_check":
+		return apperr.Validation(apperr.FieldViolation{Field: "parent_id", Message: "must not be the category itself or one of its descendants"})
+	}
+	return err
+}
@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	"chi-demo/repository/testdata"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryImpl_GetAll(t *testing.T) {
+	ctx := context.Background()
+	TestWithTxDB(t, func(tx db.ContextExecutor) {
+		// Given
+		repo, err := NewCategoryRepository(tx)
+		require.NoError(t, err)
+		testdata.LoadTestSQLFile(t, tx, "testdata/categories.sql")
+
+		// When
+		result, err := repo.GetAll(ctx)
+
+		// Then
+		require.NoError(t, err)
+		names := make([]string, len(result))
+		for i, c := range result {
+			names[i] = c.Name
+		}
+		require.Equal(t, []string{"clothing", "garden", "running", "shirts", "shoes"}, names)
+		require.Equal(t, int64(2), result[2].ParentID)
+		require.Zero(t, result[0].ParentID)
+	})
+}
+
+func TestCategoryImpl_Create(t *testing.T) {
+	type args struct {
+		givenCategory model.Category
+		expErr        error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenCategory: model.Category{ParentID: 2, Name: "hiking"},
+		},
+		"success: root": {
+			givenCategory: model.Category{Name: "kitchen"},
+		},
+		"success: same name under another parent": {
+			givenCategory: model.Category{ParentID: 5, Name: "shoes"},
+		},
+		"error: duplicate sibling": {
+			givenCategory: model.Category{ParentID: 1, Name: "Shoes"},
+			expErr:        apperr.Conflict("category name already exists under this parent", nil),
+		},
+		"error: unknown parent": {
+			givenCategory: model.Category{ParentID: 1000, Name: "hiking"},
+			expErr:        apperr.Validation(apperr.FieldViolation{Field: "parent_id", Message: "does not exist"}),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewCategoryRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/categories.sql")
+
+				// When
+				result, err := repo.Create(ctx, tc.givenCategory)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.NotZero(t, result.ID)
+					require.Equal(t, tc.givenCategory.ParentID, result.ParentID)
+					require.Equal(t, tc.givenCategory.Name, result.Name)
+				}
+			})
+		})
+	}
+}
+
+func TestCategoryImpl_Update(t *testing.T) {
+	type args struct {
+		givenCategory model.Category
+		expErr        error
+	}
+
+	tcs := map[string]args{
+		"success: rename": {
+			givenCategory: model.Category{ID: 2, ParentID: 1, Name: "footwear"},
+		},
+		"success: move subtree": {
+			givenCategory: model.Category{ID: 2, ParentID: 5, Name: "shoes"},
+		},
+		"success: make root": {
+			givenCategory: model.Category{ID: 3, Name: "running"},
+		},
+		"error: under itself": {
+			givenCategory: model.Category{ID: 2, ParentID: 2, Name: "shoes"},
+			expErr:        apperr.Validation(apperr.FieldViolation{Field: "parent_id", Message: "must not be the category itself or one of its descendants"}),
+		},
+		"error: under a child": {
+			givenCategory: model.Category{ID: 2, ParentID: 3, Name: "shoes"},
+			expErr:        apperr.Validation(apperr.FieldViolation{Field: "parent_id", Message: "must not be the category itself or one of its descendants"}),
+		},
+		"error: under a descendant": {
+			givenCategory: model.Category{ID: 1, ParentID: 3, Name: "clothing"},
+			expErr:        apperr.Validation(apperr.FieldViolation{Field: "parent_id", Message: "must not be the category itself or one of its descendants"}),
+		},
+		"error: not found": {
+			givenCategory: model.Category{ID: 1000, Name: "hiking"},
+			expErr:        apperr.NotFound("category", sql.ErrNoRows),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewCategoryRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/categories.sql")
+
+				// When
+				result, err := repo.Update(ctx, tc.givenCategory)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.givenCategory.ParentID, result.ParentID)
+					require.Equal(t, tc.givenCategory.Name, result.Name)
+				}
+			})
+		})
+	}
+}
+
+func TestCategoryImpl_Delete(t *testing.T) {
+	type args struct {
+		givenID int64
+		expErr  error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID: 3,
+		},
+		"error: has subcategories": {
+			givenID: 1,
+			expErr:  apperr.Conflict("category has subcategories", nil),
+		},
+		"error: not found": {
+			givenID: 1000,
+			expErr:  apperr.NotFound("category", sql.ErrNoRows),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewCategoryRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/categories.sql")
+
+				// When
+				err = repo.Delete(ctx, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					categories, err := repo.GetByProduct(ctx, 1)
+					require.NoError(t, err)
+					require.Len(t, categories, 1)
+				}
+			})
+		})
+	}
+}
+
+func TestCategoryImpl_SetProductCategories(t *testing.T) {
+	type args struct {
+		givenProductID   int64
+		givenCategoryIDs []int64
+		expIDs           []int64
+		expErr           error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID:   1,
+			givenCategoryIDs: []int64{5, 4},
+			expIDs:           []int64{5, 4},
+		},
+		"success: none": {
+			givenProductID: 1,
+			expIDs:         []int64{},
+		},
+		"error: unknown category": {
+			givenProductID:   1,
+			givenCategoryIDs: []int64{1000},
+			expErr:           apperr.Validation(apperr.FieldViolation{Field: "category_ids", Message: "contains an unknown category"}),
+		},
+		"error: unknown product": {
+			givenProductID:   1000,
+			givenCategoryIDs: []int64{1},
+			expErr:           apperr.NotFound("product", nil),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewCategoryRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/categories.sql")
+
+				// When
+				err = repo.SetProductCategories(ctx, tc.givenProductID, tc.givenCategoryIDs)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					categories, err := repo.GetByProduct(ctx, tc.givenProductID)
+					require.NoError(t, err)
+					ids := make([]int64, len(categories))
+					for i, c := range categories {
+						ids[i] = c.ID
+					}
+					require.Equal(t, tc.expIDs, ids)
+				}
+			})
+		})
+	}
+}
+
+func TestImpl_GetAll_Category(t *testing.T) {
+	type args struct {
+		givenQuery model.ProductListQuery
+		expIDs     []int64
+		expTotal   int64
+	}
+
+	tcs := map[string]args{
+		"success: descendants": {
+			givenQuery: model.ProductListQuery{Limit: 10, CategoryID: 1},
+			expIDs:     []int64{1, 2},
+			expTotal:   2,
+		},
+		"success: leaf": {
+			givenQuery: model.ProductListQuery{Limit: 10, CategoryID: 3},
+			expIDs:     []int64{1},
+			expTotal:   1,
+		},
+		"success: include deleted": {
+			givenQuery: model.ProductListQuery{Limit: 10, CategoryID: 2, ReadOptions: model.ReadOptions{IncludeDeleted: true}},
+			expIDs:     []int64{1, 4},
+			expTotal:   2,
+		},
+		"success: empty": {
+			givenQuery: model.ProductListQuery{Limit: 10, CategoryID: 1000},
+			expIDs:     []int64{},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := New(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/categories.sql")
+
+				// When
+				result, err := repo.GetAll(ctx, tc.givenQuery)
+
+				// Then
+				require.NoError(t, err)
+				ids := make([]int64, len(result.Products))
+				for i, p := range result.Products {
+					ids[i] = p.ID
+				}
+				require.Equal(t, tc.expIDs, ids)
+				require.Equal(t, tc.expTotal, result.Total)
+			})
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func (i APIKeyRepositoryImpl) Create(ctx context.Context, key model.APIKey) (model.APIKey, error) {
+	newID, err := i.idsnf.NextID()
+	if err != nil {
+		return model.APIKey{}, fmt.Errorf("%w", err)
+	}
+
+	scopes := make(types.StringArray, len(key.Scopes))
+	for i, s := range key.Scopes {
+		scopes[i] = string(s)
+	}
+
+	k := models.APIKey{
+		ID:        int64(newID),
+		Name:      key.Name,
+		KeyHash:   key.KeyHash,
+		Scopes:    scopes,
+		CreatedBy: key.CreatedBy,
+	}
+
+	if err := k.Insert(ctx, i.db, boil.Infer()); err != nil {
+		return model.APIKey{}, err
+	}
+
+	return toAPIKey(&k), nil
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Create inserts a category under a new sonyflake id, as a root when it has
+// no parent.
+func (i CategoryRepositoryImpl) Create(ctx context.Context, category model.Category) (_ model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryRepository.Create")
+	defer tracing.End(span, &err)
+
+	newID, err := i.idsnf.NextID()
+	if err != nil {
+		return model.Category{}, fmt.Errorf("%w", err)
+	}
+	c := models.Category{
+		ID:       int64(newID),
+		ParentID: nullID(category.ParentID),
+		Name:     category.Name,
+	}
+
+	// created_at and updated_at are set by the generated Insert
+	if err := c.Insert(ctx, i.db, boil.Infer()); err != nil {
+		return model.Category{}, categoryErr(err)
+	}
+
+	return toCategory(&c), nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Create inserts a price under a new sonyflake id. A price overlapping
+// another one of the product in the same currency is a conflict.
+func (i PriceRepositoryImpl) Create(ctx context.Context, price model.ProductPrice) (_ model.ProductPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceRepository.Create")
+	defer tracing.End(span, &err)
+
+	newID, err := i.idsnf.NextID()
+	if err != nil {
+		return model.ProductPrice{}, fmt.Errorf("%w", err)
+	}
+	p := models.ProductPrice{
+		ID:        int64(newID),
+		ProductID: price.ProductID,
+		Currency:  price.Price.Currency,
+		Amount:    price.Price.Amount,
+		ValidFrom: price.ValidFrom,
+	}
+	if !price.ValidTo.IsZero() {
+		p.ValidTo = null.TimeFrom(price.ValidTo)
+	}
+
+	// created_at is set by the generated Insert
+	if err := p.Insert(ctx, i.db, boil.Infer()); err != nil {
+		return model.ProductPrice{}, priceErr(err)
+	}
+
+	return toProductPrice(&p), nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// pq error code of unique_violation
+const uniqueViolation = "23505"
+
+func (i UserRepositoryImpl) Create(ctx context.Context, user model.User) (model.User, error) {
+	newID, err := i.idsnf.NextID()
+	if err != nil {
+		return model.User{}, fmt.Errorf("%w", err)
+	}
+	u := models.User{
+		ID:           int64(newID),
+		Username:     user.Username,
+		PasswordHash: user.PasswordHash,
+		Role:         string(user.Role),
+	}
+
+	if err := u.Insert(ctx, i.db, boil.Infer()); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return model.User{}, ErrUsernameTaken
+		}
+		return model.User{}, err
+	}
+
+	return toUser(&u), nil
+}
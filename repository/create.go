@@ -3,26 +3,34 @@ package repository
 import (
 	"chi-demo/model"
 	models "chi-demo/my_models"
+	"chi-demo/tracing"
 	"context"
 	"fmt"
 
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
-func (i ProductRepositoryImpl) Create(ctx context.Context, product model.Product) error {
+// Create inserts product under a new sonyflake id and returns it as stored,
+// timestamps included.
+func (i ProductRepositoryImpl) Create(ctx context.Context, product model.Product) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.Create")
+	defer tracing.End(span, &err)
+
 	newID, err := i.idsnf.NextID()
 	if err != nil {
-		return fmt.Errorf("%w", err)
+		return model.Product{}, fmt.Errorf("%w", err)
 	}
 	p := models.Product{
-		ID:    int64(newID),
-		Name:  product.Name,
-		Price: product.Price,
+		ID:       int64(newID),
+		Name:     product.Name,
+		Price:    product.Price.Amount,
+		Currency: product.Price.Currency,
 	}
 
+	// created_at and updated_at are set by the generated Insert
 	if err := p.Insert(ctx, i.db, boil.Infer()); err != nil {
-		return err
+		return model.Product{}, productErr(err)
 	}
 
-	return nil
+	return toProduct(&p), nil
 }
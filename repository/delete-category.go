@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// Delete removes a category and its product assignments, a category with
+// subcategories is a conflict.
+func (i CategoryRepositoryImpl) Delete(ctx context.Context, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "CategoryRepository.Delete")
+	defer tracing.End(span, &err)
+
+	rowsAff, err := models.Categories(models.CategoryWhere.ID.EQ(id)).DeleteAll(ctx, i.db)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+		return apperr.Conflict("category has subcategories", err)
+	}
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return categoryErr(sql.ErrNoRows)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"database/sql"
+)
+
+// Delete removes a price from the price list of a product.
+func (i PriceRepositoryImpl) Delete(ctx context.Context, productID, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "PriceRepository.Delete")
+	defer tracing.End(span, &err)
+
+	rowsAff, err := models.ProductPrices(
+		models.ProductPriceWhere.ID.EQ(id),
+		models.ProductPriceWhere.ProductID.EQ(productID),
+	).DeleteAll(ctx, i.db)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return priceErr(sql.ErrNoRows)
+	}
+	return nil
+}
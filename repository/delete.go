@@ -2,16 +2,30 @@ package repository
 
 import (
 	models "chi-demo/my_models"
+	"chi-demo/tracing"
 	"context"
+	"database/sql"
+	"time"
 )
 
-func (i ProductRepositoryImpl) Delete(ctx context.Context, id int64) error {
-	product, err := models.FindProduct(ctx, i.db, id)
+// Delete soft-deletes a product by setting its deleted_at tombstone.
+func (i ProductRepositoryImpl) Delete(ctx context.Context, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.Delete")
+	defer tracing.End(span, &err)
+
+	now := time.Now()
+	rowsAff, err := models.Products(
+		models.ProductWhere.ID.EQ(id),
+		models.ProductWhere.DeletedAt.IsNull(),
+	).UpdateAll(ctx, i.db, models.M{
+		models.ProductColumns.DeletedAt: now,
+		models.ProductColumns.UpdatedAt: now,
+	})
 	if err != nil {
 		return err
 	}
-	if _, err := product.Delete(ctx, i.db); err != nil {
-		return err
+	if rowsAff == 0 {
+		return productErr(sql.ErrNoRows)
 	}
 	return nil
 }
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"chi-demo/db"
+	"chi-demo/model"
+	"context"
+)
+
+type ExchangeRateRepositoryImpl struct {
+	db db.ContextExecutor
+}
+
+type ExchangeRateRepository interface {
+	Get(ctx context.Context) (model.ExchangeRates, error)
+	Replace(ctx context.Context, rates model.ExchangeRates) (model.ExchangeRates, error)
+}
+
+func NewExchangeRateRepository(db db.ContextExecutor) ExchangeRateRepository {
+	return ExchangeRateRepositoryImpl{
+		db: db,
+	}
+}
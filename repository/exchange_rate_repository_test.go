@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"chi-demo/db"
+	"chi-demo/model"
+	"chi-demo/repository/testdata"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRateImpl_Get(t *testing.T) {
+	ctx := context.Background()
+	TestWithTxDB(t, func(tx db.ContextExecutor) {
+		// Given
+		repo := NewExchangeRateRepository(tx)
+		testdata.LoadTestSQLFile(t, tx, "testdata/exchange_rates.sql")
+
+		// When
+		result, err := repo.Get(ctx)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "USD", result.Base)
+		require.Len(t, result.Rates, 2)
+		require.True(t, decimal.RequireFromString("0.92").Equal(result.Rates["EUR"]))
+		require.True(t, decimal.RequireFromString("151.2").Equal(result.Rates["JPY"]))
+		require.True(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC).Equal(result.UpdatedAt))
+	})
+}
+
+func TestExchangeRateImpl_Replace(t *testing.T) {
+	ctx := context.Background()
+	TestWithTxDB(t, func(tx db.ContextExecutor) {
+		// Given
+		repo := NewExchangeRateRepository(tx)
+		testdata.LoadTestSQLFile(t, tx, "testdata/exchange_rates.sql")
+
+		// When
+		replaced, err := repo.Replace(ctx, model.ExchangeRates{
+			Base: "EUR",
+			Rates: map[string]decimal.Decimal{
+				"USD": decimal.RequireFromString("1.08"),
+				"JPY": decimal.RequireFromString("163.5"),
+			},
+		})
+		require.NoError(t, err)
+		result, err := repo.Get(ctx)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "EUR", result.Base)
+		// EUR is the base now, its rate is gone
+		require.Len(t, result.Rates, 2)
+		require.True(t, decimal.RequireFromString("1.08").Equal(result.Rates["USD"]))
+		require.True(t, decimal.RequireFromString("163.5").Equal(result.Rates["JPY"]))
+		require.True(t, replaced.UpdatedAt.Equal(result.UpdatedAt))
+	})
+}
@@ -3,26 +3,82 @@ package repository
 import (
 	"chi-demo/model"
 	models "chi-demo/my_models"
+	"chi-demo/tracing"
 	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-func (i ProductRepositoryImpl) GetAll(ctx context.Context) ([]model.Product, error) {
-	products, err := models.Products().All(ctx, i.db)
+// inCategoryQuery matches the products of a category or of any of its
+// descendants. UNION drops the rows already walked, so a cycle cannot loop it.
+const inCategoryQuery = `"product"."id" IN (
+	WITH RECURSIVE subtree AS (
+		SELECT "id" FROM "category" WHERE "id" = ?
+		UNION
+		SELECT "category"."id" FROM "category" JOIN subtree ON "category"."parent_id" = subtree."id"
+	)
+	SELECT "product_id" FROM "product_category" WHERE "category_id" IN (SELECT "id" FROM subtree)
+)`
+
+func (i ProductRepositoryImpl) GetAll(ctx context.Context, query model.ProductListQuery) (_ model.ProductPage, err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.GetAll")
+	defer tracing.End(span, &err)
+
+	mods, err := filterMods(query.Filters)
+	if err != nil {
+		return model.ProductPage{}, err
+	}
+	if !query.IncludeDeleted {
+		mods = append(mods, models.ProductWhere.DeletedAt.IsNull())
+	}
+	if query.CategoryID > 0 {
+		mods = append(mods, qm.Where(inCategoryQuery, query.CategoryID))
+	}
+
+	order, err := orderBy(query.Sort)
+	if err != nil {
+		return model.ProductPage{}, err
+	}
+
+	total, err := models.Products(mods...).Count(ctx, i.db)
+	if err != nil {
+		return model.ProductPage{}, err
+	}
+
+	if query.AfterID > 0 {
+		mods = append(mods, models.ProductWhere.ID.GT(query.AfterID))
+	}
+	// fetch one extra row to know whether there is a next page
+	mods = append(mods,
+		order,
+		qm.Limit(query.Limit+1),
+		qm.Offset(query.Offset),
+	)
+
+	products, err := models.Products(mods...).All(ctx, i.db)
 	if err != nil {
-		return nil, err
+		return model.ProductPage{}, err
+	}
+
+	// keyset cursors follow the id order, so only hand one out for
+	// unsorted listings
+	var nextID int64
+	if len(products) > query.Limit {
+		products = products[:query.Limit]
+		if len(query.Sort) == 0 {
+			nextID = products[len(products)-1].ID
+		}
 	}
 
 	result := make([]model.Product, len(products))
 
 	for i, v := range products {
-		result[i] = model.Product{
-			ID:        v.ID,
-			Name:      v.Name,
-			Price:     v.Price,
-			CreatedAt: v.CreatedAt,
-			UpdatedAt: v.UpdatedAt,
-		}
+		result[i] = toProduct(v)
 	}
 
-	return result, nil
+	return model.ProductPage{
+		Products: result,
+		Total:    total,
+		NextID:   nextID,
+	}, nil
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+)
+
+func (i APIKeyRepositoryImpl) GetByHash(ctx context.Context, keyHash string) (model.APIKey, error) {
+	key, err := models.APIKeys(models.APIKeyWhere.KeyHash.EQ(keyHash)).One(ctx, i.db)
+	if err != nil {
+		return model.APIKey{}, apiKeyErr(err)
+	}
+	return toAPIKey(key), nil
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetAll lists every API key, revoked ones included, newest first.
+func (i APIKeyRepositoryImpl) GetAll(ctx context.Context) ([]model.APIKey, error) {
+	keys, err := models.APIKeys(qm.OrderBy(models.APIKeyColumns.ID+" desc")).All(ctx, i.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.APIKey, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, toAPIKey(k))
+	}
+	return result, nil
+}
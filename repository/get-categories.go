@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetAll returns the whole taxonomy by name, the tree is rebuilt from the
+// parent ids.
+func (i CategoryRepositoryImpl) GetAll(ctx context.Context) (_ []model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryRepository.GetAll")
+	defer tracing.End(span, &err)
+
+	categories, err := models.Categories(
+		qm.OrderBy(models.CategoryColumns.Name+", "+models.CategoryColumns.ID),
+	).All(ctx, i.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategories(categories), nil
+}
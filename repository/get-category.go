@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+)
+
+func (i CategoryRepositoryImpl) GetOne(ctx context.Context, id int64) (_ model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryRepository.GetOne")
+	defer tracing.End(span, &err)
+
+	category, err := models.FindCategory(ctx, i.db, id)
+	if err != nil {
+		return model.Category{}, categoryErr(err)
+	}
+	return toCategory(category), nil
+}
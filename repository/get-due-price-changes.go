@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetDue returns up to limit pending price changes of live products that
+// became effective by the given time, in the order they must be applied.
+func (i PriceChangeRepositoryImpl) GetDue(ctx context.Context, at time.Time, limit int) (_ []model.PriceChange, err error) {
+	ctx, span := tracer.Start(ctx, "PriceChangeRepository.GetDue")
+	defer tracing.End(span, &err)
+
+	changes, err := models.PriceChanges(
+		models.PriceChangeWhere.AppliedAt.IsNull(),
+		models.PriceChangeWhere.EffectiveAt.LTE(at),
+		// tombstoned products keep their changes until restored
+		qm.Where(`EXISTS (SELECT 1 FROM "product" WHERE "product"."id" = "price_change"."product_id" AND "product"."deleted_at" IS NULL)`),
+		qm.OrderBy(models.PriceChangeColumns.EffectiveAt+", "+models.PriceChangeColumns.ID),
+		qm.Limit(limit),
+	).All(ctx, i.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.PriceChange, len(changes))
+	for i, c := range changes {
+		result[i] = toPriceChange(c)
+	}
+	return result, nil
+}
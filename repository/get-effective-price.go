@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetEffective returns the price of a product in currency valid at the given
+// time, the exclusion constraint guarantees there is at most one.
+func (i PriceRepositoryImpl) GetEffective(ctx context.Context, productID int64, currency string, at time.Time) (_ model.ProductPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceRepository.GetEffective")
+	defer tracing.End(span, &err)
+
+	price, err := models.ProductPrices(
+		models.ProductPriceWhere.ProductID.EQ(productID),
+		models.ProductPriceWhere.Currency.EQ(currency),
+		models.ProductPriceWhere.ValidFrom.LTE(at),
+		qm.Expr(
+			models.ProductPriceWhere.ValidTo.IsNull(),
+			qm.Or2(models.ProductPriceWhere.ValidTo.GT(null.TimeFrom(at))),
+		),
+	).One(ctx, i.db)
+	if err != nil {
+		return model.ProductPrice{}, priceErr(err)
+	}
+
+	return toProductPrice(price), nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// Get returns the current exchange rates, without a base when none were
+// loaded yet.
+func (i ExchangeRateRepositoryImpl) Get(ctx context.Context) (_ model.ExchangeRates, err error) {
+	ctx, span := tracer.Start(ctx, "ExchangeRateRepository.Get")
+	defer tracing.End(span, &err)
+
+	rows, err := models.ExchangeRates().All(ctx, i.db)
+	if err != nil {
+		return model.ExchangeRates{}, err
+	}
+
+	rates := model.ExchangeRates{Rates: make(map[string]decimal.Decimal, len(rows))}
+	for _, row := range rows {
+		rate, err := decimal.NewFromString(row.Rate.String())
+		if err != nil {
+			return model.ExchangeRates{}, err
+		}
+		rates.Base = row.Base
+		rates.Rates[row.Currency] = rate
+		if row.UpdatedAt.After(rates.UpdatedAt) {
+			rates.UpdatedAt = row.UpdatedAt
+		}
+	}
+	return rates, nil
+}
@@ -3,23 +3,24 @@ package repository
 import (
 	"chi-demo/model"
 	models "chi-demo/my_models"
+	"chi-demo/tracing"
 	"context"
-	"log"
 
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-func (i ProductRepositoryImpl) GetOne(ctx context.Context, id int64) (model.Product, error) {
-	product, err := models.Products(qm.Where("id=?", id)).One(ctx, i.db)
+func (i ProductRepositoryImpl) GetOne(ctx context.Context, id int64, opts model.ReadOptions) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.GetOne")
+	defer tracing.End(span, &err)
+
+	mods := []qm.QueryMod{models.ProductWhere.ID.EQ(id)}
+	if !opts.IncludeDeleted {
+		mods = append(mods, models.ProductWhere.DeletedAt.IsNull())
+	}
+
+	product, err := models.Products(mods...).One(ctx, i.db)
 	if err != nil {
-		log.Println(err)
-		return model.Product{}, err
+		return model.Product{}, productErr(err)
 	}
-	return model.Product{
-		ID:        product.ID,
-		Name:      product.Name,
-		Price:     product.Price,
-		CreatedAt: product.CreatedAt,
-		UpdatedAt: product.UpdatedAt,
-	}, nil
+	return toProduct(product), nil
 }
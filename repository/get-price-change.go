@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetOne returns a price change of a product, applied or scheduled.
+func (i PriceChangeRepositoryImpl) GetOne(ctx context.Context, productID, id int64) (_ model.PriceChange, err error) {
+	ctx, span := tracer.Start(ctx, "PriceChangeRepository.GetOne")
+	defer tracing.End(span, &err)
+
+	change, err := models.PriceChanges(
+		models.PriceChangeWhere.ID.EQ(id),
+		models.PriceChangeWhere.ProductID.EQ(productID),
+	).One(ctx, i.db)
+	if err != nil {
+		return model.PriceChange{}, priceChangeErr(err)
+	}
+
+	return toPriceChange(change), nil
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetHistory returns the applied and scheduled price changes of a product,
+// latest first.
+func (i PriceChangeRepositoryImpl) GetHistory(ctx context.Context, productID int64) (_ []model.PriceChange, err error) {
+	ctx, span := tracer.Start(ctx, "PriceChangeRepository.GetHistory")
+	defer tracing.End(span, &err)
+
+	changes, err := models.PriceChanges(
+		models.PriceChangeWhere.ProductID.EQ(productID),
+		qm.OrderBy(models.PriceChangeColumns.EffectiveAt+" DESC, "+models.PriceChangeColumns.ID+" DESC"),
+	).All(ctx, i.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.PriceChange, len(changes))
+	for i, c := range changes {
+		result[i] = toPriceChange(c)
+	}
+	return result, nil
+}
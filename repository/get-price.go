@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetOne returns a price of the price list of a product.
+func (i PriceRepositoryImpl) GetOne(ctx context.Context, productID, id int64) (_ model.ProductPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceRepository.GetOne")
+	defer tracing.End(span, &err)
+
+	price, err := models.ProductPrices(
+		models.ProductPriceWhere.ID.EQ(id),
+		models.ProductPriceWhere.ProductID.EQ(productID),
+	).One(ctx, i.db)
+	if err != nil {
+		return model.ProductPrice{}, priceErr(err)
+	}
+
+	return toProductPrice(price), nil
+}
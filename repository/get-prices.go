@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetAll returns the price list of a product by currency, oldest first.
+func (i PriceRepositoryImpl) GetAll(ctx context.Context, productID int64) (_ []model.ProductPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceRepository.GetAll")
+	defer tracing.End(span, &err)
+
+	prices, err := models.ProductPrices(
+		models.ProductPriceWhere.ProductID.EQ(productID),
+		qm.OrderBy(models.ProductPriceColumns.Currency+", "+models.ProductPriceColumns.ValidFrom),
+	).All(ctx, i.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.ProductPrice, len(prices))
+	for i, p := range prices {
+		result[i] = toProductPrice(p)
+	}
+	return result, nil
+}
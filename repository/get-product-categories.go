@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetByProduct returns the categories a product is directly assigned to, by
+// name.
+func (i CategoryRepositoryImpl) GetByProduct(ctx context.Context, productID int64) (_ []model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryRepository.GetByProduct")
+	defer tracing.End(span, &err)
+
+	product, err := models.Products(
+		models.ProductWhere.ID.EQ(productID),
+		qm.Load(models.ProductRels.Categories, qm.OrderBy(models.CategoryTableColumns.Name+", "+models.CategoryTableColumns.ID)),
+	).One(ctx, i.db)
+	if err != nil {
+		return nil, productErr(err)
+	}
+
+	return toCategories(product.R.GetCategories()), nil
+}
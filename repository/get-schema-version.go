@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// GetSchemaVersion returns the applied migration version, zero when no
+// migration ran yet.
+func (i HealthRepositoryImpl) GetSchemaVersion(ctx context.Context) (model.SchemaVersion, error) {
+	m, err := models.SchemaMigrations().One(ctx, i.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.SchemaVersion{}, nil
+		}
+		return model.SchemaVersion{}, err
+	}
+
+	return model.SchemaVersion{
+		Version: m.Version,
+		Dirty:   m.Dirty,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+)
+
+func (i UserRepositoryImpl) GetByID(ctx context.Context, id int64) (model.User, error) {
+	user, err := models.FindUser(ctx, i.db, id)
+	if err != nil {
+		return model.User{}, userErr(err)
+	}
+	return toUser(user), nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+)
+
+func (i UserRepositoryImpl) GetByUsername(ctx context.Context, username string) (model.User, error) {
+	user, err := models.Users(models.UserWhere.Username.EQ(username)).One(ctx, i.db)
+	if err != nil {
+		return model.User{}, userErr(err)
+	}
+	return toUser(user), nil
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"chi-demo/db"
+	"chi-demo/model"
+	"context"
+)
+
+type HealthRepositoryImpl struct {
+	db db.ContextExecutor
+}
+
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	GetSchemaVersion(ctx context.Context) (model.SchemaVersion, error)
+}
+
+func NewHealthRepository(db db.ContextExecutor) HealthRepository {
+	return HealthRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"chi-demo/db"
+	"chi-demo/model"
+	"chi-demo/repository/testdata"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthImpl_Ping(t *testing.T) {
+	type args struct {
+		expDBFailed bool
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {},
+		"error: db failed": {
+			expDBFailed: true,
+			expErr:      errors.New("sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo := NewHealthRepository(tx)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo = NewHealthRepository(dbMock)
+				}
+
+				// When
+				err := repo.Ping(ctx)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		})
+	}
+}
+
+func TestHealthImpl_GetSchemaVersion(t *testing.T) {
+	type args struct {
+		givenEmpty  bool
+		expDBFailed bool
+		expResult   model.SchemaVersion
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			expResult: model.SchemaVersion{Version: 5, Dirty: true},
+		},
+		"success - no migration": {
+			givenEmpty: true,
+		},
+		"error: db failed": {
+			expDBFailed: true,
+			expErr:      errors.New("models: failed to execute a one query for schema_migrations: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo := NewHealthRepository(tx)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo = NewHealthRepository(dbMock)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/schema_migrations.sql")
+				if tc.givenEmpty {
+					_, err := tx.ExecContext(ctx, "truncate table schema_migrations")
+					require.NoError(t, err)
+				}
+
+				// When
+				result, err := repo.GetSchemaVersion(ctx)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.expResult, result)
+				}
+			})
+		})
+	}
+}
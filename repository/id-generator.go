@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/sony/sonyflake"
+)
+
+// newIDGenerator returns the sonyflake generator a repository draws its ids
+// from. It fails without a private IP address to derive the machine id from.
+func newIDGenerator() (*sonyflake.Sonyflake, error) {
+	flake, err := sonyflake.New(sonyflake.Settings{})
+	if err != nil {
+		return nil, fmt.Errorf("repository: id generator: %w", err)
+	}
+	return flake, nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"chi-demo/metrics"
+	"chi-demo/model"
+	"context"
+	"time"
+)
+
+type productRepositoryMetrics struct {
+	next    ProductRepository
+	metrics *metrics.Metrics
+}
+
+// NewProductRepositoryMetrics records the duration of every method of next.
+func NewProductRepositoryMetrics(next ProductRepository, m *metrics.Metrics) ProductRepository {
+	return productRepositoryMetrics{
+		next:    next,
+		metrics: m,
+	}
+}
+
+func (r productRepositoryMetrics) observe(method string, start time.Time, err *error) {
+	r.metrics.ObserveQuery("product", method, start, *err)
+}
+
+func (r productRepositoryMetrics) GetOne(ctx context.Context, id int64, opts model.ReadOptions) (_ model.Product, err error) {
+	defer r.observe("GetOne", time.Now(), &err)
+	return r.next.GetOne(ctx, id, opts)
+}
+
+func (r productRepositoryMetrics) GetAll(ctx context.Context, query model.ProductListQuery) (_ model.ProductPage, err error) {
+	defer r.observe("GetAll", time.Now(), &err)
+	return r.next.GetAll(ctx, query)
+}
+
+func (r productRepositoryMetrics) Create(ctx context.Context, product model.Product) (_ model.Product, err error) {
+	defer r.observe("Create", time.Now(), &err)
+	return r.next.Create(ctx, product)
+}
+
+func (r productRepositoryMetrics) Update(ctx context.Context, product model.Product, note model.ChangeNote) (_ model.Product, err error) {
+	defer r.observe("Update", time.Now(), &err)
+	return r.next.Update(ctx, product, note)
+}
+
+func (r productRepositoryMetrics) Delete(ctx context.Context, id int64) (err error) {
+	defer r.observe("Delete", time.Now(), &err)
+	return r.next.Delete(ctx, id)
+}
+
+func (r productRepositoryMetrics) Restore(ctx context.Context, id int64) (_ model.Product, err error) {
+	defer r.observe("Restore", time.Now(), &err)
+	return r.next.Restore(ctx, id)
+}
+
+func (r productRepositoryMetrics) Purge(ctx context.Context, deletedBefore time.Time) (_ int64, err error) {
+	defer r.observe("Purge", time.Now(), &err)
+	return r.next.Purge(ctx, deletedBefore)
+}
+
+func (r productRepositoryMetrics) Search(ctx context.Context, query model.SearchQuery, page model.Page) (_ model.SearchPage, err error) {
+	defer r.observe("Search", time.Now(), &err)
+	return r.next.Search(ctx, query, page)
+}
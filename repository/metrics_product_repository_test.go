@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"chi-demo/metrics"
+	"chi-demo/model"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductRepositoryMetrics(t *testing.T) {
+	type args struct {
+		mockErr   error
+		expSeries string
+	}
+
+	tcs := map[string]args{
+		"success": {
+			expSeries: `db_query_duration_seconds_count{method="GetOne",repository="product",status="ok"} 1`,
+		},
+		"not found": {
+			mockErr:   sql.ErrNoRows,
+			expSeries: `db_query_duration_seconds_count{method="GetOne",repository="product",status="ok"} 1`,
+		},
+		"error": {
+			mockErr:   errors.New("test"),
+			expSeries: `db_query_duration_seconds_count{method="GetOne",repository="product",status="error"} 1`,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			m := metrics.New()
+			mockProductRepo := NewMockProductRepository(t)
+			mockProductRepo.ExpectedCalls = []*mock.Call{
+				mockProductRepo.On("GetOne", ctx, int64(1), model.ReadOptions{}).Return(model.Product{ID: 1}, tc.mockErr),
+			}
+
+			// When
+			result, err := NewProductRepositoryMetrics(mockProductRepo, m).GetOne(ctx, 1, model.ReadOptions{})
+
+			// Then
+			require.Equal(t, tc.mockErr, err)
+			require.Equal(t, model.Product{ID: 1}, result)
+			res := httptest.NewRecorder()
+			m.Handler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			require.Contains(t, res.Body.String(), tc.expSeries)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package repository
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCategoryRepository is an autogenerated mock type for the CategoryRepository type
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, category
+func (_m *MockCategoryRepository) Create(ctx context.Context, category model.Category) (model.Category, error) {
+	ret := _m.Called(ctx, category)
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) (model.Category, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) model.Category); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Category) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockCategoryRepository) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *MockCategoryRepository) GetAll(ctx context.Context) ([]model.Category, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Category, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByProduct provides a mock function with given fields: ctx, productID
+func (_m *MockCategoryRepository) GetByProduct(ctx context.Context, productID int64) ([]model.Category, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Category, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Category); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *MockCategoryRepository) GetOne(ctx context.Context, id int64) (model.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetProductCategories provides a mock function with given fields: ctx, productID, categoryIDs
+func (_m *MockCategoryRepository) SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64) error {
+	ret := _m.Called(ctx, productID, categoryIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) error); ok {
+		r0 = rf(ctx, productID, categoryIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, category
+func (_m *MockCategoryRepository) Update(ctx context.Context, category model.Category) (model.Category, error) {
+	ret := _m.Called(ctx, category)
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) (model.Category, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) model.Category); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Category) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockCategoryRepository creates a new instance of MockCategoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCategoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCategoryRepository {
+	mock := &MockCategoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
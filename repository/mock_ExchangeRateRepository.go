@@ -0,0 +1,77 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package repository
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExchangeRateRepository is an autogenerated mock type for the ExchangeRateRepository type
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx
+func (_m *MockExchangeRateRepository) Get(ctx context.Context) (model.ExchangeRates, error) {
+	ret := _m.Called(ctx)
+
+	var r0 model.ExchangeRates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (model.ExchangeRates, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) model.ExchangeRates); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(model.ExchangeRates)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Replace provides a mock function with given fields: ctx, rates
+func (_m *MockExchangeRateRepository) Replace(ctx context.Context, rates model.ExchangeRates) (model.ExchangeRates, error) {
+	ret := _m.Called(ctx, rates)
+
+	var r0 model.ExchangeRates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExchangeRates) (model.ExchangeRates, error)); ok {
+		return rf(ctx, rates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExchangeRates) model.ExchangeRates); ok {
+		r0 = rf(ctx, rates)
+	} else {
+		r0 = ret.Get(0).(model.ExchangeRates)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ExchangeRates) error); ok {
+		r1 = rf(ctx, rates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockExchangeRateRepository creates a new instance of MockExchangeRateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExchangeRateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
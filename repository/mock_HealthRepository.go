@@ -0,0 +1,67 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package repository
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHealthRepository is an autogenerated mock type for the HealthRepository type
+type MockHealthRepository struct {
+	mock.Mock
+}
+
+// GetSchemaVersion provides a mock function with given fields: ctx
+func (_m *MockHealthRepository) GetSchemaVersion(ctx context.Context) (model.SchemaVersion, error) {
+	ret := _m.Called(ctx)
+
+	var r0 model.SchemaVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (model.SchemaVersion, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) model.SchemaVersion); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(model.SchemaVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Ping provides a mock function with given fields: ctx
+func (_m *MockHealthRepository) Ping(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockHealthRepository creates a new instance of MockHealthRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHealthRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHealthRepository {
+	mock := &MockHealthRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
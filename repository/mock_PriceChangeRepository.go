@@ -0,0 +1,169 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package repository
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockPriceChangeRepository is an autogenerated mock type for the PriceChangeRepository type
+type MockPriceChangeRepository struct {
+	mock.Mock
+}
+
+// Apply provides a mock function with given fields: ctx, id, at
+func (_m *MockPriceChangeRepository) Apply(ctx context.Context, id int64, at time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (bool, error)); ok {
+		return rf(ctx, id, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) bool); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Cancel provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceChangeRepository) Cancel(ctx context.Context, productID int64, id int64) error {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDue provides a mock function with given fields: ctx, at, limit
+func (_m *MockPriceChangeRepository) GetDue(ctx context.Context, at time.Time, limit int) ([]model.PriceChange, error) {
+	ret := _m.Called(ctx, at, limit)
+
+	var r0 []model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]model.PriceChange, error)); ok {
+		return rf(ctx, at, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []model.PriceChange); ok {
+		r0 = rf(ctx, at, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, at, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: ctx, productID
+func (_m *MockPriceChangeRepository) GetHistory(ctx context.Context, productID int64) ([]model.PriceChange, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.PriceChange, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.PriceChange); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceChangeRepository) GetOne(ctx context.Context, productID int64, id int64) (model.PriceChange, error) {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.PriceChange, error)); ok {
+		return rf(ctx, productID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.PriceChange); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Get(0).(model.PriceChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, productID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Schedule provides a mock function with given fields: ctx, change
+func (_m *MockPriceChangeRepository) Schedule(ctx context.Context, change model.PriceChange) (model.PriceChange, error) {
+	ret := _m.Called(ctx, change)
+
+	var r0 model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PriceChange) (model.PriceChange, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PriceChange) model.PriceChange); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Get(0).(model.PriceChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PriceChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockPriceChangeRepository creates a new instance of MockPriceChangeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPriceChangeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPriceChangeRepository {
+	mock := &MockPriceChangeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package repository
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockPriceRepository is an autogenerated mock type for the PriceRepository type
+type MockPriceRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, price
+func (_m *MockPriceRepository) Create(ctx context.Context, price model.ProductPrice) (model.ProductPrice, error) {
+	ret := _m.Called(ctx, price)
+
+	var r0 model.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductPrice) (model.ProductPrice, error)); ok {
+		return rf(ctx, price)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductPrice) model.ProductPrice); ok {
+		r0 = rf(ctx, price)
+	} else {
+		r0 = ret.Get(0).(model.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductPrice) error); ok {
+		r1 = rf(ctx, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceRepository) Delete(ctx context.Context, productID int64, id int64) error {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx, productID
+func (_m *MockPriceRepository) GetAll(ctx context.Context, productID int64) ([]model.ProductPrice, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []model.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.ProductPrice, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.ProductPrice); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEffective provides a mock function with given fields: ctx, productID, currency, at
+func (_m *MockPriceRepository) GetEffective(ctx context.Context, productID int64, currency string, at time.Time) (model.ProductPrice, error) {
+	ret := _m.Called(ctx, productID, currency, at)
+
+	var r0 model.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) (model.ProductPrice, error)); ok {
+		return rf(ctx, productID, currency, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) model.ProductPrice); ok {
+		r0 = rf(ctx, productID, currency, at)
+	} else {
+		r0 = ret.Get(0).(model.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, time.Time) error); ok {
+		r1 = rf(ctx, productID, currency, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceRepository) GetOne(ctx context.Context, productID int64, id int64) (model.ProductPrice, error) {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 model.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.ProductPrice, error)); ok {
+		return rf(ctx, productID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.ProductPrice); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Get(0).(model.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, productID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockPriceRepository creates a new instance of MockPriceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPriceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPriceRepository {
+	mock := &MockPriceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
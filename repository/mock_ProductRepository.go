@@ -7,6 +7,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockProductRepository is an autogenerated mock type for the ProductRepository type
@@ -15,17 +17,27 @@ type MockProductRepository struct {
 }
 
 // Create provides a mock function with given fields: ctx, product
-func (_m *MockProductRepository) Create(ctx context.Context, product model.Product) error {
+func (_m *MockProductRepository) Create(ctx context.Context, product model.Product) (model.Product, error) {
 	ret := _m.Called(ctx, product)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.Product) error); ok {
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product) (model.Product, error)); ok {
+		return rf(ctx, product)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product) model.Product); ok {
 		r0 = rf(ctx, product)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.Product)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, model.Product) error); ok {
+		r1 = rf(ctx, product)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // Delete provides a mock function with given fields: ctx, id
@@ -42,25 +54,23 @@ func (_m *MockProductRepository) Delete(ctx context.Context, id int64) error {
 	return r0
 }
 
-// GetAll provides a mock function with given fields: ctx
-func (_m *MockProductRepository) GetAll(ctx context.Context) ([]model.Product, error) {
-	ret := _m.Called(ctx)
+// GetAll provides a mock function with given fields: ctx, query
+func (_m *MockProductRepository) GetAll(ctx context.Context, query model.ProductListQuery) (model.ProductPage, error) {
+	ret := _m.Called(ctx, query)
 
-	var r0 []model.Product
+	var r0 model.ProductPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Product, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductListQuery) (model.ProductPage, error)); ok {
+		return rf(ctx, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.Product); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductListQuery) model.ProductPage); ok {
+		r0 = rf(ctx, query)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Product)
-		}
+		r0 = ret.Get(0).(model.ProductPage)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductListQuery) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,8 +78,56 @@ func (_m *MockProductRepository) GetAll(ctx context.Context) ([]model.Product, e
 	return r0, r1
 }
 
-// GetOne provides a mock function with given fields: ctx, id
-func (_m *MockProductRepository) GetOne(ctx context.Context, id int64) (model.Product, error) {
+// GetOne provides a mock function with given fields: ctx, id, opts
+func (_m *MockProductRepository) GetOne(ctx context.Context, id int64, opts model.ReadOptions) (model.Product, error) {
+	ret := _m.Called(ctx, id, opts)
+
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.ReadOptions) (model.Product, error)); ok {
+		return rf(ctx, id, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.ReadOptions) model.Product); ok {
+		r0 = rf(ctx, id, opts)
+	} else {
+		r0 = ret.Get(0).(model.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.ReadOptions) error); ok {
+		r1 = rf(ctx, id, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockProductRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockProductRepository) Restore(ctx context.Context, id int64) (model.Product, error) {
 	ret := _m.Called(ctx, id)
 
 	var r0 model.Product
@@ -92,6 +150,54 @@ func (_m *MockProductRepository) GetOne(ctx context.Context, id int64) (model.Pr
 	return r0, r1
 }
 
+// Search provides a mock function with given fields: ctx, query, page
+func (_m *MockProductRepository) Search(ctx context.Context, query model.SearchQuery, page model.Page) (model.SearchPage, error) {
+	ret := _m.Called(ctx, query, page)
+
+	var r0 model.SearchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchQuery, model.Page) (model.SearchPage, error)); ok {
+		return rf(ctx, query, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchQuery, model.Page) model.SearchPage); ok {
+		r0 = rf(ctx, query, page)
+	} else {
+		r0 = ret.Get(0).(model.SearchPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SearchQuery, model.Page) error); ok {
+		r1 = rf(ctx, query, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, product, note
+func (_m *MockProductRepository) Update(ctx context.Context, product model.Product, note model.ChangeNote) (model.Product, error) {
+	ret := _m.Called(ctx, product, note)
+
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product, model.ChangeNote) (model.Product, error)); ok {
+		return rf(ctx, product, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product, model.ChangeNote) model.Product); ok {
+		r0 = rf(ctx, product, note)
+	} else {
+		r0 = ret.Get(0).(model.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Product, model.ChangeNote) error); ok {
+		r1 = rf(ctx, product, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockProductRepository creates a new instance of MockProductRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProductRepository(t interface {
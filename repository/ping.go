@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// Ping runs a trivial query, which unlike sql.DB.Ping also works inside a
+// transaction.
+func (i HealthRepositoryImpl) Ping(ctx context.Context) error {
+	var one int
+	return i.db.QueryRowContext(ctx, "select 1").Scan(&one)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sony/sonyflake"
+	"github.com/volatiletech/null/v8"
+)
+
+type PriceChangeRepositoryImpl struct {
+	db    db.ContextExecutor
+	idsnf *sonyflake.Sonyflake
+}
+
+type PriceChangeRepository interface {
+	Schedule(ctx context.Context, change model.PriceChange) (model.PriceChange, error)
+	GetHistory(ctx context.Context, productID int64) ([]model.PriceChange, error)
+	GetOne(ctx context.Context, productID, id int64) (model.PriceChange, error)
+	GetDue(ctx context.Context, at time.Time, limit int) ([]model.PriceChange, error)
+	Apply(ctx context.Context, id int64, at time.Time) (bool, error)
+	Cancel(ctx context.Context, productID, id int64) error
+}
+
+func NewPriceChangeRepository(db db.ContextExecutor) (PriceChangeRepository, error) {
+	flake, err := newIDGenerator()
+	if err != nil {
+		return nil, err
+	}
+
+	return PriceChangeRepositoryImpl{
+		db:    db,
+		idsnf: flake,
+	}, nil
+}
+
+func toPriceChange(c *models.PriceChange) model.PriceChange {
+	return model.PriceChange{
+		ID:        c.ID,
+		ProductID: c.ProductID,
+		Price: model.Money{
+			Amount:   c.Amount,
+			Currency: c.Currency,
+		},
+		Previous: model.Money{
+			Amount:   c.PreviousAmount.Int64,
+			Currency: c.PreviousCurrency.String,
+		},
+		ChangeNote: model.ChangeNote{
+			ChangedBy: model.Actor{
+				UserID:   c.ChangedByUserID.Int64,
+				APIKeyID: c.ChangedByAPIKeyID.Int64,
+			},
+			Reason: c.Reason,
+		},
+		EffectiveAt: c.EffectiveAt,
+		AppliedAt:   c.AppliedAt.Time,
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+// nullID stores a zero id as null.
+func nullID(id int64) null.Int64 {
+	return null.NewInt64(id, id != 0)
+}
+
+// priceChangeErr turns the database errors of the price change queries into
+// domain errors.
+func priceChangeErr(err error) error {
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return apperr.NotFound("price change", err)
+	case errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation && pqErr.Constraint == "price_change_product_id_fkey":
+		return apperr.NotFound("product", err)
+	}
+	return err
+}
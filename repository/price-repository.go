@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sony/sonyflake"
+)
+
+type PriceRepositoryImpl struct {
+	db    db.ContextExecutor
+	idsnf *sonyflake.Sonyflake
+}
+
+type PriceRepository interface {
+	GetAll(ctx context.Context, productID int64) ([]model.ProductPrice, error)
+	GetOne(ctx context.Context, productID, id int64) (model.ProductPrice, error)
+	GetEffective(ctx context.Context, productID int64, currency string, at time.Time) (model.ProductPrice, error)
+	Create(ctx context.Context, price model.ProductPrice) (model.ProductPrice, error)
+	Delete(ctx context.Context, productID, id int64) error
+}
+
+func NewPriceRepository(db db.ContextExecutor) (PriceRepository, error) {
+	flake, err := newIDGenerator()
+	if err != nil {
+		return nil, err
+	}
+
+	return PriceRepositoryImpl{
+		db:    db,
+		idsnf: flake,
+	}, nil
+}
+
+func toProductPrice(p *models.ProductPrice) model.ProductPrice {
+	return model.ProductPrice{
+		ID:        p.ID,
+		ProductID: p.ProductID,
+		Price: model.Money{
+			Amount:   p.Amount,
+			Currency: p.Currency,
+		},
+		ValidFrom: p.ValidFrom,
+		ValidTo:   p.ValidTo.Time,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// pq error codes of the price constraints
+const (
+	foreignKeyViolation = "23503"
+	exclusionViolation  = "23P01"
+)
+
+// priceErr turns the database errors of the price queries into domain
+// errors.
+func priceErr(err error) error {
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return apperr.NotFound("price", err)
+	case errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation:
+		return apperr.NotFound("product", err)
+	case errors.As(err, &pqErr) && pqErr.Code == exclusionViolation:
+		return apperr.Conflict("price overlaps another price in this currency", err)
+	case errors.As(err, &pqErr) && pqErr.Code == checkViolation && pqErr.Constraint == "product_price_validity_check":
+		return apperr.Validation(apperr.FieldViolation{Field: "valid_to", Message: "must be after valid_from"})
+	}
+	return err
+}
@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	"chi-demo/repository/testdata"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceChangeImpl_Schedule(t *testing.T) {
+	type args struct {
+		givenProductID int64
+		expErr         error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID: 1,
+		},
+		"error: product not found": {
+			givenProductID: 1000,
+			expErr:         apperr.NotFound("product", nil),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceChangeRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/price_changes.sql")
+				change := model.PriceChange{
+					ProductID: tc.givenProductID,
+					Price:     model.Money{Amount: 900, Currency: "USD"},
+					ChangeNote: model.ChangeNote{
+						ChangedBy: model.Actor{UserID: 1},
+						Reason:    "black friday",
+					},
+					EffectiveAt: time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC),
+				}
+
+				// When
+				result, err := repo.Schedule(ctx, change)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.NotZero(t, result.ID)
+					require.NotZero(t, result.CreatedAt)
+					require.Equal(t, model.PriceChangeScheduled, result.Status())
+					require.Equal(t, change.Price, result.Price)
+					require.Equal(t, change.ChangeNote, result.ChangeNote)
+				}
+			})
+		})
+	}
+}
+
+func TestPriceChangeImpl_GetHistory(t *testing.T) {
+	type args struct {
+		givenProductID int64
+		expDBFailed    bool
+		expIDs         []int64
+		expErr         error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID: 1,
+			expIDs:         []int64{3, 2, 1},
+		},
+		"success: no history": {
+			givenProductID: 1000,
+			expIDs:         []int64{},
+		},
+		"error: db failed": {
+			givenProductID: 1,
+			expDBFailed:    true,
+			expErr:         errors.New("models: failed to assign all query results to PriceChange slice: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceChangeRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewPriceChangeRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/price_changes.sql")
+
+				// When
+				result, err := repo.GetHistory(ctx, tc.givenProductID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					ids := make([]int64, len(result))
+					for i, c := range result {
+						ids[i] = c.ID
+					}
+					require.Equal(t, tc.expIDs, ids)
+				}
+			})
+		})
+	}
+}
+
+func TestPriceChangeImpl_GetOne(t *testing.T) {
+	type args struct {
+		givenProductID int64
+		givenID        int64
+		expErr         error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID: 1,
+			givenID:        2,
+		},
+		"error: other product": {
+			givenProductID: 2,
+			givenID:        2,
+			expErr:         apperr.NotFound("price change", sql.ErrNoRows),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceChangeRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/price_changes.sql")
+
+				// When
+				result, err := repo.GetOne(ctx, tc.givenProductID, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, "summer sale", result.ChangeNote.Reason)
+					require.True(t, result.AppliedAt.IsZero())
+				}
+			})
+		})
+	}
+}
+
+func TestPriceChangeImpl_GetDue(t *testing.T) {
+	type args struct {
+		givenAt time.Time
+		expIDs  []int64
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenAt: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+			expIDs:  []int64{2},
+		},
+		"success: in order": {
+			givenAt: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+			expIDs:  []int64{2, 3},
+		},
+		"success: none due": {
+			givenAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			expIDs:  []int64{},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceChangeRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/price_changes.sql")
+
+				// When
+				result, err := repo.GetDue(ctx, tc.givenAt, 10)
+
+				// Then
+				require.NoError(t, err)
+				ids := make([]int64, len(result))
+				for i, c := range result {
+					ids[i] = c.ID
+				}
+				require.Equal(t, tc.expIDs, ids)
+			})
+		})
+	}
+}
+
+func TestPriceChangeImpl_Apply(t *testing.T) {
+	type args struct {
+		givenID    int64
+		expApplied bool
+		expPrice   int64
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID:    2,
+			expApplied: true,
+			expPrice:   800,
+		},
+		"success: already applied": {
+			givenID:  1,
+			expPrice: 1000,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceChangeRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/price_changes.sql")
+				at := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+				// When
+				applied, err := repo.Apply(ctx, tc.givenID, at)
+
+				// Then
+				require.NoError(t, err)
+				require.Equal(t, tc.expApplied, applied)
+				productRepo, err := New(tx)
+				require.NoError(t, err)
+				product, err := productRepo.GetOne(ctx, 1, model.ReadOptions{})
+				require.NoError(t, err)
+				require.Equal(t, tc.expPrice, product.Price.Amount)
+				if tc.expApplied {
+					history, err := repo.GetHistory(ctx, 1)
+					require.NoError(t, err)
+					require.Equal(t, tc.givenID, history[1].ID)
+					require.True(t, at.Equal(history[1].AppliedAt))
+					require.Equal(t, model.Money{Amount: 1000, Currency: "USD"}, history[1].Previous)
+				}
+			})
+		})
+	}
+}
+
+func TestPriceChangeImpl_Cancel(t *testing.T) {
+	type args struct {
+		givenProductID int64
+		givenID        int64
+		expErr         error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID: 1,
+			givenID:        2,
+		},
+		"error: applied": {
+			givenProductID: 1,
+			givenID:        1,
+			expErr:         apperr.NotFound("price change", sql.ErrNoRows),
+		},
+		"error: other product": {
+			givenProductID: 2,
+			givenID:        2,
+			expErr:         apperr.NotFound("price change", sql.ErrNoRows),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceChangeRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/price_changes.sql")
+
+				// When
+				err = repo.Cancel(ctx, tc.givenProductID, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		})
+	}
+}
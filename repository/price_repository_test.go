@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	"chi-demo/repository/testdata"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceImpl_GetAll(t *testing.T) {
+	type args struct {
+		givenProductID int64
+		expDBFailed    bool
+		expIDs         []int64
+		expErr         error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID: 1,
+			expIDs:         []int64{3, 1, 2},
+		},
+		"success: no prices": {
+			givenProductID: 1000,
+			expIDs:         []int64{},
+		},
+		"error: db failed": {
+			givenProductID: 1,
+			expDBFailed:    true,
+			expErr:         errors.New("models: failed to assign all query results to ProductPrice slice: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewPriceRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/product_prices.sql")
+
+				// When
+				result, err := repo.GetAll(ctx, tc.givenProductID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					ids := make([]int64, len(result))
+					for i, p := range result {
+						ids[i] = p.ID
+					}
+					require.Equal(t, tc.expIDs, ids)
+				}
+			})
+		})
+	}
+}
+
+func TestPriceImpl_GetOne(t *testing.T) {
+	type args struct {
+		givenProductID int64
+		givenID        int64
+		expErr         error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID: 1,
+			givenID:        2,
+		},
+		"error: other product": {
+			givenProductID: 1000,
+			givenID:        2,
+			expErr:         apperr.NotFound("price", sql.ErrNoRows),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/product_prices.sql")
+
+				// When
+				result, err := repo.GetOne(ctx, tc.givenProductID, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, model.Money{Amount: 950, Currency: "EUR"}, result.Price)
+					require.True(t, result.ValidTo.IsZero())
+				}
+			})
+		})
+	}
+}
+
+func TestPriceImpl_GetEffective(t *testing.T) {
+	type args struct {
+		givenCurrency string
+		givenAt       time.Time
+		expPrice      model.Money
+		expErr        error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenCurrency: "EUR",
+			givenAt:       time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			expPrice:      model.Money{Amount: 900, Currency: "EUR"},
+		},
+		"success: valid_to is exclusive": {
+			givenCurrency: "EUR",
+			givenAt:       time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			expPrice:      model.Money{Amount: 950, Currency: "EUR"},
+		},
+		"success: open-ended": {
+			givenCurrency: "CHF",
+			givenAt:       time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			expPrice:      model.Money{Amount: 895, Currency: "CHF"},
+		},
+		"error: not valid yet": {
+			givenCurrency: "EUR",
+			givenAt:       time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			expErr:        apperr.NotFound("price", sql.ErrNoRows),
+		},
+		"error: other currency": {
+			givenCurrency: "GBP",
+			givenAt:       time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			expErr:        apperr.NotFound("price", sql.ErrNoRows),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/product_prices.sql")
+
+				// When
+				result, err := repo.GetEffective(ctx, 1, tc.givenCurrency, tc.givenAt)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.expPrice, result.Price)
+				}
+			})
+		})
+	}
+}
+
+func TestPriceImpl_Create(t *testing.T) {
+	type args struct {
+		givenPrice model.ProductPrice
+		expErr     error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenPrice: model.ProductPrice{
+				ProductID: 1,
+				Price:     model.Money{Amount: 1000, Currency: "GBP"},
+				ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"success: adjacent": {
+			givenPrice: model.ProductPrice{
+				ProductID: 1,
+				Price:     model.Money{Amount: 850, Currency: "EUR"},
+				ValidFrom: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				ValidTo:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"error: overlapping": {
+			givenPrice: model.ProductPrice{
+				ProductID: 1,
+				Price:     model.Money{Amount: 850, Currency: "EUR"},
+				ValidFrom: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expErr: apperr.Conflict("price overlaps another price in this currency", nil),
+		},
+		"error: product not found": {
+			givenPrice: model.ProductPrice{
+				ProductID: 1000,
+				Price:     model.Money{Amount: 1000, Currency: "GBP"},
+				ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expErr: apperr.NotFound("product", nil),
+		},
+		"error: empty validity": {
+			givenPrice: model.ProductPrice{
+				ProductID: 1,
+				Price:     model.Money{Amount: 1000, Currency: "GBP"},
+				ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				ValidTo:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expErr: apperr.Validation(apperr.FieldViolation{Field: "valid_to", Message: "must be after valid_from"}),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/product_prices.sql")
+
+				// When
+				result, err := repo.Create(ctx, tc.givenPrice)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.NotZero(t, result.ID)
+					require.NotZero(t, result.CreatedAt)
+					require.Equal(t, tc.givenPrice.Price, result.Price)
+					require.True(t, tc.givenPrice.ValidFrom.Equal(result.ValidFrom))
+					require.True(t, tc.givenPrice.ValidTo.Equal(result.ValidTo))
+				}
+			})
+		})
+	}
+}
+
+func TestPriceImpl_Delete(t *testing.T) {
+	type args struct {
+		givenProductID int64
+		givenID        int64
+		expErr         error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProductID: 1,
+			givenID:        1,
+		},
+		"error: not found": {
+			givenProductID: 1,
+			givenID:        1000,
+			expErr:         apperr.NotFound("price", sql.ErrNoRows),
+		},
+		"error: other product": {
+			givenProductID: 2,
+			givenID:        1,
+			expErr:         apperr.NotFound("price", sql.ErrNoRows),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewPriceRepository(tx)
+				require.NoError(t, err)
+				testdata.LoadTestSQLFile(t, tx, "testdata/product_prices.sql")
+
+				// When
+				err = repo.Delete(ctx, tc.givenProductID, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		})
+	}
+}
@@ -1,34 +1,77 @@
 package repository
 
 import (
+	"chi-demo/apperr"
 	"chi-demo/db"
 	"chi-demo/model"
+	models "chi-demo/my_models"
 	"context"
-	"fmt"
+	"database/sql"
+	"errors"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/sony/sonyflake"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer starts the spans of every repository method
+var tracer = otel.Tracer("chi-demo/repository")
+
 type ProductRepositoryImpl struct {
 	db    db.ContextExecutor
 	idsnf *sonyflake.Sonyflake
 }
 
 type ProductRepository interface {
-	GetOne(ctx context.Context, id int64) (model.Product, error)
-	GetAll(ctx context.Context) ([]model.Product, error)
-	Create(ctx context.Context, product model.Product) error
+	GetOne(ctx context.Context, id int64, opts model.ReadOptions) (model.Product, error)
+	GetAll(ctx context.Context, query model.ProductListQuery) (model.ProductPage, error)
+	Create(ctx context.Context, product model.Product) (model.Product, error)
+	Update(ctx context.Context, product model.Product, note model.ChangeNote) (model.Product, error)
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) (model.Product, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	Search(ctx context.Context, query model.SearchQuery, page model.Page) (model.SearchPage, error)
 }
 
-func New(db db.ContextExecutor) ProductRepository {
-	flake := sonyflake.NewSonyflake(sonyflake.Settings{})
-	if flake == nil {
-		fmt.Printf("Couldn't generate sonyflake.NewSonyflake. Doesn't work on Go Playground due to fake time.\n")
+func New(db db.ContextExecutor) (ProductRepository, error) {
+	flake, err := newIDGenerator()
+	if err != nil {
+		return nil, err
 	}
 
 	return ProductRepositoryImpl{
 		db:    db,
 		idsnf: flake,
+	}, nil
+}
+
+func toProduct(p *models.Product) model.Product {
+	return model.Product{
+		ID:   p.ID,
+		Name: p.Name,
+		Price: model.Money{
+			Amount:   p.Price,
+			Currency: p.Currency,
+		},
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+		DeletedAt: p.DeletedAt.Time,
+	}
+}
+
+// pq error code of check_violation
+const checkViolation = "23514"
+
+// productErr turns the database errors of the product queries into domain
+// errors.
+func productErr(err error) error {
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return apperr.NotFound("product", err)
+	case errors.As(err, &pqErr) && pqErr.Code == checkViolation && pqErr.Constraint == "product_price_check":
+		return apperr.Validation(apperr.FieldViolation{Field: "price.amount", Message: "must be positive"})
 	}
+	return err
 }
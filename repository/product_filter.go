@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// filterMods compiles product filters into query mods. like and ilike match
+// the value as a substring.
+func filterMods(filters []model.ProductFilter) ([]qm.QueryMod, error) {
+	mods := make([]qm.QueryMod, 0, len(filters))
+
+	for _, f := range filters {
+		mod, err := filterMod(f)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, mod)
+	}
+
+	return mods, nil
+}
+
+func filterMod(f model.ProductFilter) (qm.QueryMod, error) {
+	switch f.Field {
+	case models.ProductColumns.ID:
+		v, ok := f.Value.(int64)
+		if !ok {
+			break
+		}
+		w := models.ProductWhere.ID
+		switch f.Operator {
+		case model.FilterEQ:
+			return w.EQ(v), nil
+		case model.FilterNEQ:
+			return w.NEQ(v), nil
+		case model.FilterLT:
+			return w.LT(v), nil
+		case model.FilterLTE:
+			return w.LTE(v), nil
+		case model.FilterGT:
+			return w.GT(v), nil
+		case model.FilterGTE:
+			return w.GTE(v), nil
+		}
+	case models.ProductColumns.Name:
+		v, ok := f.Value.(string)
+		if !ok {
+			break
+		}
+		w := models.ProductWhere.Name
+		switch f.Operator {
+		case model.FilterEQ:
+			return w.EQ(v), nil
+		case model.FilterNEQ:
+			return w.NEQ(v), nil
+		case model.FilterLike:
+			return w.LIKE("%" + likeEscaper.Replace(v) + "%"), nil
+		case model.FilterILike:
+			return w.ILIKE("%" + likeEscaper.Replace(v) + "%"), nil
+		}
+	case models.ProductColumns.Price:
+		v, ok := f.Value.(int64)
+		if !ok {
+			break
+		}
+		w := models.ProductWhere.Price
+		switch f.Operator {
+		case model.FilterEQ:
+			return w.EQ(v), nil
+		case model.FilterNEQ:
+			return w.NEQ(v), nil
+		case model.FilterLT:
+			return w.LT(v), nil
+		case model.FilterLTE:
+			return w.LTE(v), nil
+		case model.FilterGT:
+			return w.GT(v), nil
+		case model.FilterGTE:
+			return w.GTE(v), nil
+		}
+	case models.ProductColumns.Currency:
+		v, ok := f.Value.(string)
+		if !ok {
+			break
+		}
+		w := models.ProductWhere.Currency
+		switch f.Operator {
+		case model.FilterEQ:
+			return w.EQ(v), nil
+		case model.FilterNEQ:
+			return w.NEQ(v), nil
+		}
+	case models.ProductColumns.CreatedAt, models.ProductColumns.UpdatedAt:
+		v, ok := f.Value.(time.Time)
+		if !ok {
+			break
+		}
+		w := models.ProductWhere.CreatedAt
+		if f.Field == models.ProductColumns.UpdatedAt {
+			w = models.ProductWhere.UpdatedAt
+		}
+		switch f.Operator {
+		case model.FilterEQ:
+			return w.EQ(v), nil
+		case model.FilterNEQ:
+			return w.NEQ(v), nil
+		case model.FilterLT:
+			return w.LT(v), nil
+		case model.FilterLTE:
+			return w.LTE(v), nil
+		case model.FilterGT:
+			return w.GT(v), nil
+		case model.FilterGTE:
+			return w.GTE(v), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported product filter %s[%s]=%v", f.Field, f.Operator, f.Value)
+}
+
+// orderBy compiles the sort into an ORDER BY clause, always ending with the id
+// so that pages are deterministic.
+func orderBy(sort []model.ProductSort) (qm.QueryMod, error) {
+	clauses := make([]string, 0, len(sort)+1)
+
+	for _, s := range sort {
+		switch s.Field {
+		case models.ProductColumns.ID,
+			models.ProductColumns.Name,
+			models.ProductColumns.Price,
+			models.ProductColumns.CreatedAt,
+			models.ProductColumns.UpdatedAt:
+		default:
+			return nil, fmt.Errorf("unsupported product sort field %s", s.Field)
+		}
+
+		clause := s.Field
+		if s.Desc {
+			clause += " DESC"
+		}
+		clauses = append(clauses, clause)
+
+		if s.Field == models.ProductColumns.ID {
+			return qm.OrderBy(strings.Join(clauses, ", ")), nil
+		}
+	}
+
+	clauses = append(clauses, models.ProductColumns.ID)
+	return qm.OrderBy(strings.Join(clauses, ", ")), nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"chi-demo/apperr"
 	"chi-demo/db"
 	"chi-demo/model"
 	"chi-demo/repository/testdata"
@@ -8,6 +9,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/go-cmp/cmp"
@@ -18,15 +20,25 @@ import (
 
 func TestImpl_Create(t *testing.T) {
 	type args struct {
+		givenPrice  model.Money
 		expDBFailed bool
 		expErr      error
 	}
 
 	tcs := map[string]args{
 		"success": {
-			expErr: nil,
+			givenPrice: model.Money{Amount: 1, Currency: "USD"},
+			expErr:     nil,
+		},
+		"success: other currency": {
+			givenPrice: model.Money{Amount: 1250, Currency: "JPY"},
+		},
+		"error: invalid price": {
+			givenPrice: model.Money{Amount: -1, Currency: "USD"},
+			expErr:     apperr.Validation(apperr.FieldViolation{Field: "price.amount", Message: "must be positive"}),
 		},
 		"error: db failed": {
+			givenPrice:  model.Money{Amount: 1, Currency: "USD"},
 			expDBFailed: true,
 			expErr:      errors.New("models: unable to insert into product: sql: database is closed"),
 		},
@@ -37,11 +49,13 @@ func TestImpl_Create(t *testing.T) {
 			ctx := context.Background()
 			TestWithTxDB(t, func(tx db.ContextExecutor) {
 				// Given
-				repo := New(tx)
+				repo, err := New(tx)
+				require.NoError(t, err)
 				if tc.expDBFailed {
 					dbMock, _, _ := sqlmock.New()
 					dbMock.Close()
-					repo = New(dbMock)
+					repo, err = New(dbMock)
+					require.NoError(t, err)
 				}
 				testdata.LoadTestSQLFile(t, tx, "testdata/create_product.sql")
 
@@ -49,15 +63,21 @@ func TestImpl_Create(t *testing.T) {
 				product := model.Product{
 					ID:    1,
 					Name:  "test",
-					Price: 1,
+					Price: tc.givenPrice,
 				}
-				err := repo.Create(ctx, product)
+				rs, err := repo.Create(ctx, product)
 
 				// Then
 				if tc.expErr != nil {
 					require.EqualError(t, err, tc.expErr.Error())
 				} else {
 					require.NoError(t, err)
+					// the id is generated, the given one is ignored
+					require.NotEqual(t, product.ID, rs.ID)
+					require.Equal(t, product.Name, rs.Name)
+					require.Equal(t, product.Price, rs.Price)
+					require.False(t, rs.CreatedAt.IsZero())
+					require.Equal(t, rs.CreatedAt, rs.UpdatedAt)
 				}
 			})
 		})
@@ -67,7 +87,9 @@ func TestImpl_Create(t *testing.T) {
 func TestImpl_GetById(t *testing.T) {
 	type args struct {
 		givenID     int64
+		givenOpts   model.ReadOptions
 		expDBFailed bool
+		expDeleted  bool
 		expRs       model.Product
 		expErr      error
 	}
@@ -78,12 +100,26 @@ func TestImpl_GetById(t *testing.T) {
 			expRs: model.Product{
 				ID:    1,
 				Name:  "test",
-				Price: 1,
+				Price: model.Money{Amount: 1, Currency: "USD"},
+			},
+		},
+		"success: include deleted": {
+			givenID:    2,
+			givenOpts:  model.ReadOptions{IncludeDeleted: true},
+			expDeleted: true,
+			expRs: model.Product{
+				ID:    2,
+				Name:  "deleted",
+				Price: model.Money{Amount: 2, Currency: "USD"},
 			},
 		},
 		"error: not found": {
 			givenID: 1000,
-			expErr:  sql.ErrNoRows,
+			expErr:  apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: deleted": {
+			givenID: 2,
+			expErr:  apperr.NotFound("product", sql.ErrNoRows),
 		},
 		"error: db failed": {
 			givenID:     1,
@@ -97,16 +133,18 @@ func TestImpl_GetById(t *testing.T) {
 			ctx := context.Background()
 			TestWithTxDB(t, func(tx db.ContextExecutor) {
 				// Given
-				repo := New(tx)
+				repo, err := New(tx)
+				require.NoError(t, err)
 				if tc.expDBFailed {
 					dbMock, _, _ := sqlmock.New()
 					dbMock.Close()
-					repo = New(dbMock)
+					repo, err = New(dbMock)
+					require.NoError(t, err)
 				}
 				testdata.LoadTestSQLFile(t, tx, "testdata/get_product_by_id.sql")
 
 				// When
-				result, err := repo.GetOne(ctx, tc.givenID)
+				result, err := repo.GetOne(ctx, tc.givenID, tc.givenOpts)
 
 				// Then
 				if tc.expErr != nil {
@@ -115,7 +153,11 @@ func TestImpl_GetById(t *testing.T) {
 					require.NoError(t, err)
 					require.NotZero(t, result.CreatedAt)
 					require.NotZero(t, result.UpdatedAt)
-					require.NotZero(t, result.DeletedAt)
+					if tc.expDeleted {
+						require.NotZero(t, result.DeletedAt)
+					} else {
+						require.Zero(t, result.DeletedAt)
+					}
 					if !cmp.Equal(tc.expRs, result,
 						cmpopts.IgnoreFields(model.Product{}, "CreatedAt", "UpdatedAt", "DeletedAt")) {
 						t.Errorf("\n order mismatched. \n expected: %+v \n got: %+v \n diff: %+v", tc.expRs, result,
@@ -131,33 +173,171 @@ func TestImpl_GetById(t *testing.T) {
 func TestImpl_GetAll(t *testing.T) {
 	type args struct {
 		isEmpty     bool
+		givenQuery  model.ProductListQuery
 		expDBFailed bool
-		expRs       []model.Product
+		expRs       model.ProductPage
 		expErr      error
 	}
 
 	tcs := map[string]args{
 		"success": {
-			expRs: []model.Product{
-				{
-					ID:    1,
-					Name:  "test1",
-					Price: 1,
+			givenQuery: model.ProductListQuery{Limit: 20},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    1,
+						Name:  "test1",
+						Price: model.Money{Amount: 1, Currency: "USD"},
+					},
+					{
+						ID:    2,
+						Name:  "test2",
+						Price: model.Money{Amount: 2, Currency: "USD"},
+					},
 				},
-				{
-					ID:    2,
-					Name:  "test2",
-					Price: 2,
+				Total: 2,
+			},
+		},
+		"success: include deleted": {
+			givenQuery: model.ProductListQuery{
+				ReadOptions: model.ReadOptions{IncludeDeleted: true},
+				Limit:       20,
+			},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    1,
+						Name:  "test1",
+						Price: model.Money{Amount: 1, Currency: "USD"},
+					},
+					{
+						ID:    2,
+						Name:  "test2",
+						Price: model.Money{Amount: 2, Currency: "USD"},
+					},
+					{
+						ID:    3,
+						Name:  "test3",
+						Price: model.Money{Amount: 3, Currency: "USD"},
+					},
+				},
+				Total: 3,
+			},
+		},
+		"success: first page": {
+			givenQuery: model.ProductListQuery{Limit: 1},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    1,
+						Name:  "test1",
+						Price: model.Money{Amount: 1, Currency: "USD"},
+					},
+				},
+				Total:  2,
+				NextID: 1,
+			},
+		},
+		"success: after cursor": {
+			givenQuery: model.ProductListQuery{Limit: 1, AfterID: 1},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    2,
+						Name:  "test2",
+						Price: model.Money{Amount: 2, Currency: "USD"},
+					},
+				},
+				Total: 2,
+			},
+		},
+		"success: offset": {
+			givenQuery: model.ProductListQuery{Limit: 20, Offset: 1},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    2,
+						Name:  "test2",
+						Price: model.Money{Amount: 2, Currency: "USD"},
+					},
+				},
+				Total: 2,
+			},
+		},
+		"success: filters": {
+			givenQuery: model.ProductListQuery{
+				Limit: 20,
+				Filters: []model.ProductFilter{
+					{Field: "price", Operator: model.FilterGTE, Value: int64(2)},
+					{Field: "name", Operator: model.FilterILike, Value: "TEST"},
+				},
+			},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    2,
+						Name:  "test2",
+						Price: model.Money{Amount: 2, Currency: "USD"},
+					},
+				},
+				Total: 1,
+			},
+		},
+		"success: currency filter": {
+			givenQuery: model.ProductListQuery{
+				Limit: 20,
+				Filters: []model.ProductFilter{
+					{Field: "currency", Operator: model.FilterNEQ, Value: "EUR"},
+					{Field: "price", Operator: model.FilterGTE, Value: int64(2)},
+				},
+			},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    2,
+						Name:  "test2",
+						Price: model.Money{Amount: 2, Currency: "USD"},
+					},
 				},
+				Total: 1,
 			},
 		},
+		"success: sort": {
+			givenQuery: model.ProductListQuery{
+				Limit: 1,
+				Sort:  []model.ProductSort{{Field: "price", Desc: true}},
+			},
+			expRs: model.ProductPage{
+				Products: []model.Product{
+					{
+						ID:    2,
+						Name:  "test2",
+						Price: model.Money{Amount: 2, Currency: "USD"},
+					},
+				},
+				Total: 2,
+			},
+		},
+		"error: unsupported filter": {
+			givenQuery: model.ProductListQuery{
+				Limit: 20,
+				Filters: []model.ProductFilter{
+					{Field: "price", Operator: model.FilterILike, Value: 2},
+				},
+			},
+			expErr: errors.New("unsupported product filter price[ilike]=2"),
+		},
 		"empty": {
-			isEmpty: true,
-			expRs:   []model.Product{},
+			isEmpty:    true,
+			givenQuery: model.ProductListQuery{Limit: 20},
+			expRs: model.ProductPage{
+				Products: []model.Product{},
+			},
 		},
 		"error: db failed": {
+			givenQuery:  model.ProductListQuery{Limit: 20},
 			expDBFailed: true,
-			expErr:      errors.New("models: failed to assign all query results to Product slice: bind failed to execute query: sql: database is closed"),
+			expErr:      errors.New("models: failed to count product rows: sql: database is closed"),
 		},
 	}
 
@@ -166,30 +346,176 @@ func TestImpl_GetAll(t *testing.T) {
 			ctx := context.Background()
 			TestWithTxDB(t, func(tx db.ContextExecutor) {
 				// Given
-				repo := New(tx)
+				repo, err := New(tx)
+				require.NoError(t, err)
 				if tc.expDBFailed {
 					dbMock, _, _ := sqlmock.New()
 					dbMock.Close()
-					repo = New(dbMock)
+					repo, err = New(dbMock)
+					require.NoError(t, err)
 				}
 				if !tc.isEmpty {
 					testdata.LoadTestSQLFile(t, tx, "testdata/get_all_products.sql")
 				}
 
 				// When
-				result, err := repo.GetAll(ctx)
+				result, err := repo.GetAll(ctx, tc.givenQuery)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					if !cmp.Equal(tc.expRs, result,
+						cmpopts.IgnoreFields(model.Product{}, "CreatedAt", "UpdatedAt", "DeletedAt")) {
+						t.Errorf("\n order mismatched. \n expected: %+v \n got: %+v \n diff: %+v", tc.expRs, result,
+							cmp.Diff(tc.expRs, result, cmpopts.IgnoreFields(model.Product{}, "CreatedAt", "UpdatedAt", "DeletedAt")))
+						t.FailNow()
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestImpl_Update(t *testing.T) {
+	type args struct {
+		givenProduct model.Product
+		expDBFailed  bool
+		expRs        model.Product
+		expHistory   []model.PriceChange
+		expErr       error
+	}
+
+	note := model.ChangeNote{
+		ChangedBy: model.Actor{UserID: 1},
+		Reason:    "supplier price increase",
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenProduct: model.Product{
+				ID:    1,
+				Name:  "test updated",
+				Price: model.Money{Amount: 2, Currency: "USD"},
+			},
+			expRs: model.Product{
+				ID:    1,
+				Name:  "test updated",
+				Price: model.Money{Amount: 2, Currency: "USD"},
+			},
+			expHistory: []model.PriceChange{
+				{
+					ProductID:  1,
+					Price:      model.Money{Amount: 2, Currency: "USD"},
+					Previous:   model.Money{Amount: 1, Currency: "USD"},
+					ChangeNote: note,
+				},
+			},
+		},
+		"success: same price": {
+			givenProduct: model.Product{
+				ID:    1,
+				Name:  "test updated",
+				Price: model.Money{Amount: 1, Currency: "USD"},
+			},
+			expRs: model.Product{
+				ID:    1,
+				Name:  "test updated",
+				Price: model.Money{Amount: 1, Currency: "USD"},
+			},
+			expHistory: []model.PriceChange{},
+		},
+		"success: other currency": {
+			givenProduct: model.Product{
+				ID:    1,
+				Name:  "test updated",
+				Price: model.Money{Amount: 1, Currency: "EUR"},
+			},
+			expRs: model.Product{
+				ID:    1,
+				Name:  "test updated",
+				Price: model.Money{Amount: 1, Currency: "EUR"},
+			},
+			expHistory: []model.PriceChange{
+				{
+					ProductID:  1,
+					Price:      model.Money{Amount: 1, Currency: "EUR"},
+					Previous:   model.Money{Amount: 1, Currency: "USD"},
+					ChangeNote: note,
+				},
+			},
+		},
+		"error: not found": {
+			givenProduct: model.Product{
+				ID:    1000,
+				Name:  "test updated",
+				Price: model.Money{Amount: 2, Currency: "USD"},
+			},
+			expErr: apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: deleted": {
+			givenProduct: model.Product{
+				ID:    2,
+				Name:  "test updated",
+				Price: model.Money{Amount: 2, Currency: "USD"},
+			},
+			expErr: apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: db failed": {
+			givenProduct: model.Product{
+				ID:    1,
+				Name:  "test updated",
+				Price: model.Money{Amount: 2, Currency: "USD"},
+			},
+			expDBFailed: true,
+			expErr:      errors.New("bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := New(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = New(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/update_product.sql")
+
+				// When
+				result, err := repo.Update(ctx, tc.givenProduct, note)
 
 				// Then
 				if tc.expErr != nil {
 					require.EqualError(t, err, tc.expErr.Error())
 				} else {
 					require.NoError(t, err)
+					require.True(t, result.UpdatedAt.After(result.CreatedAt))
 					if !cmp.Equal(tc.expRs, result,
 						cmpopts.IgnoreFields(model.Product{}, "CreatedAt", "UpdatedAt", "DeletedAt")) {
 						t.Errorf("\n order mismatched. \n expected: %+v \n got: %+v \n diff: %+v", tc.expRs, result,
 							cmp.Diff(tc.expRs, result, cmpopts.IgnoreFields(model.Product{}, "CreatedAt", "UpdatedAt", "DeletedAt")))
 						t.FailNow()
 					}
+
+					// the price change is recorded by the same statement
+					priceChangeRepo, err := NewPriceChangeRepository(tx)
+					require.NoError(t, err)
+					history, err := priceChangeRepo.GetHistory(ctx, tc.givenProduct.ID)
+					require.NoError(t, err)
+					require.Len(t, history, len(tc.expHistory))
+					for i, change := range history {
+						require.Equal(t, model.PriceChangeApplied, change.Status())
+						require.True(t, change.EffectiveAt.Equal(result.UpdatedAt))
+						require.Empty(t, cmp.Diff(tc.expHistory[i], change,
+							cmpopts.IgnoreFields(model.PriceChange{}, "ID", "EffectiveAt", "AppliedAt", "CreatedAt")))
+					}
 				}
 			})
 		})
@@ -210,12 +536,16 @@ func TestImpl_DeleteById(t *testing.T) {
 		},
 		"error: not found": {
 			givenID: 1000,
-			expErr:  sql.ErrNoRows,
+			expErr:  apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: already deleted": {
+			givenID: 2,
+			expErr:  apperr.NotFound("product", sql.ErrNoRows),
 		},
 		"error: db failed": {
 			givenID:     1,
 			expDBFailed: true,
-			expErr:      errors.New("models: unable to select from product: bind failed to execute query: sql: database is closed"),
+			expErr:      errors.New("models: unable to update all for product: sql: database is closed"),
 		},
 	}
 
@@ -224,22 +554,305 @@ func TestImpl_DeleteById(t *testing.T) {
 			ctx := context.Background()
 			TestWithTxDB(t, func(tx db.ContextExecutor) {
 				// Given
-				repo := New(tx)
+				repo, err := New(tx)
+				require.NoError(t, err)
 				if tc.expDBFailed {
 					dbMock, _, _ := sqlmock.New()
 					dbMock.Close()
-					repo = New(dbMock)
+					repo, err = New(dbMock)
+					require.NoError(t, err)
 				}
 				testdata.LoadTestSQLFile(t, tx, "testdata/delete_product_by_id.sql")
 
 				// When
-				err := repo.Delete(ctx, tc.givenID)
+				err = repo.Delete(ctx, tc.givenID)
 
 				// Then
 				if tc.expErr != nil {
 					require.EqualError(t, err, tc.expErr.Error())
 				} else {
 					require.NoError(t, err)
+					product, err := repo.GetOne(ctx, tc.givenID, model.ReadOptions{IncludeDeleted: true})
+					require.NoError(t, err)
+					require.NotZero(t, product.DeletedAt)
+				}
+			})
+		})
+	}
+}
+
+func TestImpl_Restore(t *testing.T) {
+	type args struct {
+		givenID     int64
+		expDBFailed bool
+		expRs       model.Product
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID: 2,
+			expRs: model.Product{
+				ID:    2,
+				Name:  "deleted",
+				Price: model.Money{Amount: 2, Currency: "USD"},
+			},
+		},
+		"error: not deleted": {
+			givenID: 1,
+			expErr:  apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: not found": {
+			givenID: 1000,
+			expErr:  apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: db failed": {
+			givenID:     2,
+			expDBFailed: true,
+			expErr:      errors.New("models: failed to execute a one query for product: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := New(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = New(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/restore_product_by_id.sql")
+
+				// When
+				result, err := repo.Restore(ctx, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Zero(t, result.DeletedAt)
+					if !cmp.Equal(tc.expRs, result,
+						cmpopts.IgnoreFields(model.Product{}, "CreatedAt", "UpdatedAt", "DeletedAt")) {
+						t.Errorf("\n order mismatched. \n expected: %+v \n got: %+v \n diff: %+v", tc.expRs, result,
+							cmp.Diff(tc.expRs, result, cmpopts.IgnoreFields(model.Product{}, "CreatedAt", "UpdatedAt", "DeletedAt")))
+						t.FailNow()
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestImpl_Purge(t *testing.T) {
+	type args struct {
+		givenDeletedBefore time.Time
+		expDBFailed        bool
+		expRs              int64
+		expErr             error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenDeletedBefore: time.Now().Add(-24 * time.Hour),
+			expRs:              1,
+		},
+		"success: nothing to purge": {
+			givenDeletedBefore: time.Now().Add(-365 * 24 * time.Hour),
+			expRs:              0,
+		},
+		"error: db failed": {
+			givenDeletedBefore: time.Now(),
+			expDBFailed:        true,
+			expErr:             errors.New("models: unable to delete all from product: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := New(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = New(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/purge_products.sql")
+
+				// When
+				result, err := repo.Purge(ctx, tc.givenDeletedBefore)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.expRs, result)
+				}
+			})
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tcs := map[string]struct {
+		givenName string
+		givenTerm string
+		expRes    string
+	}{
+		"match": {
+			givenName: "Running Shoes",
+			givenTerm: "shoe",
+			expRes:    "Running <mark>Shoe</mark>s",
+		},
+		"markup escaped": {
+			givenName: "<script>alert(1)</script> bag",
+			givenTerm: "bag",
+			expRes:    "&lt;script&gt;alert(1)&lt;/script&gt; <mark>bag</mark>",
+		},
+		"term with markup": {
+			givenName: "salt & pepper",
+			givenTerm: "&",
+			expRes:    "salt <mark>&amp;</mark> pepper",
+		},
+		"no term": {
+			givenName: "<b>bold</b>",
+			expRes:    "&lt;b&gt;bold&lt;/b&gt;",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// When
+			result := highlight(tc.givenName, tc.givenTerm)
+
+			// Then
+			require.Equal(t, tc.expRes, result)
+		})
+	}
+}
+
+func TestMarkSnippet(t *testing.T) {
+	// Given
+	snippet := "<script>alert(1)</script> " + matchStart + "bag" + matchStop
+
+	// When
+	result := markSnippet(snippet)
+
+	// Then
+	require.Equal(t, "&lt;script&gt;alert(1)&lt;/script&gt; <mark>bag</mark>", result)
+}
+
+func TestImpl_Search(t *testing.T) {
+	type args struct {
+		givenQuery  model.SearchQuery
+		givenPage   model.Page
+		expDBFailed bool
+		expIDs      []int64
+		expSnippets []string
+		expTotal    int64
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success: full text": {
+			givenQuery:  model.SearchQuery{Text: "shoes"},
+			givenPage:   model.Page{Limit: 20},
+			expIDs:      []int64{1, 2},
+			expSnippets: []string{"running <mark>shoes</mark>", "<mark>shoe</mark> polish"},
+			expTotal:    2,
+		},
+		"success: prefix": {
+			givenQuery:  model.SearchQuery{Text: "runn", Prefix: true},
+			givenPage:   model.Page{Limit: 20},
+			expIDs:      []int64{1},
+			expSnippets: []string{"<mark>running</mark> shoes"},
+			expTotal:    1,
+		},
+		"success: paged": {
+			givenQuery: model.SearchQuery{Text: "shoes"},
+			givenPage:  model.Page{Limit: 1, Offset: 1},
+			expIDs:     []int64{2},
+			expTotal:   2,
+		},
+		"success: trigram fallback": {
+			givenQuery:  model.SearchQuery{Text: "ck", Fuzzy: true},
+			givenPage:   model.Page{Limit: 20},
+			expIDs:      []int64{3},
+			expSnippets: []string{"tennis ra<mark>ck</mark>et"},
+			expTotal:    1,
+		},
+		"success: full text markup escaped": {
+			givenQuery:  model.SearchQuery{Text: "bag"},
+			givenPage:   model.Page{Limit: 20},
+			expIDs:      []int64{5},
+			expSnippets: []string{"&lt;script&gt;alert(1)&lt;/script&gt; <mark>bag</mark>"},
+			expTotal:    1,
+		},
+		"success: trigram markup escaped": {
+			givenQuery:  model.SearchQuery{Text: "script", Fuzzy: true},
+			givenPage:   model.Page{Limit: 20},
+			expIDs:      []int64{5},
+			expSnippets: []string{"&lt;<mark>script</mark>&gt;alert(1)&lt;/<mark>script</mark>&gt; bag"},
+			expTotal:    1,
+		},
+		"success: no terms": {
+			givenQuery: model.SearchQuery{Text: "!&|"},
+			givenPage:  model.Page{Limit: 20},
+			expIDs:     []int64{},
+		},
+		"error: db failed": {
+			givenQuery:  model.SearchQuery{Text: "shoes"},
+			givenPage:   model.Page{Limit: 20},
+			expDBFailed: true,
+			expErr:      errors.New("bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := New(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = New(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/search_products.sql")
+
+				// When
+				result, err := repo.Search(ctx, tc.givenQuery, tc.givenPage)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.expTotal, result.Total)
+					ids := make([]int64, len(result.Results))
+					for i, r := range result.Results {
+						ids[i] = r.Product.ID
+						if !tc.givenQuery.Fuzzy {
+							require.Positive(t, r.Rank)
+						}
+						if tc.expSnippets != nil {
+							require.Equal(t, tc.expSnippets[i], r.Snippet)
+						}
+					}
+					require.Equal(t, tc.expIDs, ids)
 				}
 			})
 		})
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// Purge hard-deletes products that were tombstoned before the given time and
+// returns the number of rows removed.
+func (i ProductRepositoryImpl) Purge(ctx context.Context, deletedBefore time.Time) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.Purge")
+	defer tracing.End(span, &err)
+
+	return models.Products(
+		models.ProductWhere.DeletedAt.LT(null.TimeFrom(deletedBefore)),
+	).DeleteAll(ctx, i.db)
+}
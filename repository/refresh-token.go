@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// CreateRefreshToken stores a refresh token. A token without a family starts
+// a new one, identified by its own id.
+func (i TokenRepositoryImpl) CreateRefreshToken(ctx context.Context, token model.RefreshToken) (model.RefreshToken, error) {
+	newID, err := i.idsnf.NextID()
+	if err != nil {
+		return model.RefreshToken{}, fmt.Errorf("%w", err)
+	}
+
+	t := models.RefreshToken{
+		ID:        int64(newID),
+		UserID:    token.UserID,
+		FamilyID:  token.FamilyID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	}
+	if t.FamilyID == 0 {
+		t.FamilyID = t.ID
+	}
+
+	if err := t.Insert(ctx, i.db, boil.Infer()); err != nil {
+		return model.RefreshToken{}, err
+	}
+
+	return toRefreshToken(&t), nil
+}
+
+func (i TokenRepositoryImpl) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (model.RefreshToken, error) {
+	token, err := models.RefreshTokens(models.RefreshTokenWhere.TokenHash.EQ(tokenHash)).One(ctx, i.db)
+	if err != nil {
+		return model.RefreshToken{}, refreshTokenErr(err)
+	}
+	return toRefreshToken(token), nil
+}
+
+// RevokeRefreshToken revokes a single live token. Only one caller can revoke
+// a given token, the others get ErrAlreadyRevoked.
+func (i TokenRepositoryImpl) RevokeRefreshToken(ctx context.Context, id int64) error {
+	now := time.Now()
+	rowsAff, err := models.RefreshTokens(
+		models.RefreshTokenWhere.ID.EQ(id),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, i.db, models.M{
+		models.RefreshTokenColumns.RevokedAt: now,
+		models.RefreshTokenColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrAlreadyRevoked
+	}
+	return nil
+}
+
+// RevokeRefreshTokenFamily revokes every live token of a family.
+func (i TokenRepositoryImpl) RevokeRefreshTokenFamily(ctx context.Context, familyID int64) error {
+	now := time.Now()
+	_, err := models.RefreshTokens(
+		models.RefreshTokenWhere.FamilyID.EQ(familyID),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, i.db, models.M{
+		models.RefreshTokenColumns.RevokedAt: now,
+		models.RefreshTokenColumns.UpdatedAt: now,
+	})
+	return err
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// replaceExchangeRatesQuery upserts the new rates and deletes the others in
+// a single statement, so readers never see a partial table.
+const replaceExchangeRatesQuery = `WITH upserted AS (
+	INSERT INTO "exchange_rate" ("currency", "base", "rate", "updated_at")
+	SELECT r.currency, $3, r.rate, $4
+	FROM unnest($1::char(3)[], $2::numeric[]) AS r (currency, rate)
+	ON CONFLICT ("currency") DO UPDATE
+	SET "base" = EXCLUDED."base", "rate" = EXCLUDED."rate", "updated_at" = EXCLUDED."updated_at"
+	RETURNING "currency"
+)
+DELETE FROM "exchange_rate" WHERE "currency" NOT IN (SELECT "currency" FROM upserted)`
+
+// Replace swaps the whole exchange rate table for rates.
+func (i ExchangeRateRepositoryImpl) Replace(ctx context.Context, rates model.ExchangeRates) (_ model.ExchangeRates, err error) {
+	ctx, span := tracer.Start(ctx, "ExchangeRateRepository.Replace")
+	defer tracing.End(span, &err)
+
+	currencies := make([]string, 0, len(rates.Rates))
+	values := make([]string, 0, len(rates.Rates))
+	for currency, rate := range rates.Rates {
+		currencies = append(currencies, currency)
+		values = append(values, rate.String())
+	}
+	// timestamptz keeps microseconds
+	now := time.Now().Truncate(time.Microsecond)
+
+	_, err = queries.Raw(replaceExchangeRatesQuery, pq.Array(currencies), pq.Array(values), rates.Base, now).ExecContext(ctx, i.db)
+	if err != nil {
+		return model.ExchangeRates{}, err
+	}
+
+	rates.UpdatedAt = now
+	return rates, nil
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Restore clears the tombstone of a soft-deleted product.
+func (i ProductRepositoryImpl) Restore(ctx context.Context, id int64) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.Restore")
+	defer tracing.End(span, &err)
+
+	product, err := models.Products(
+		models.ProductWhere.ID.EQ(id),
+		models.ProductWhere.DeletedAt.IsNotNull(),
+	).One(ctx, i.db)
+	if err != nil {
+		return model.Product{}, productErr(err)
+	}
+
+	product.DeletedAt = null.Time{}
+	if _, err := product.Update(ctx, i.db, boil.Whitelist(
+		models.ProductColumns.DeletedAt,
+		models.ProductColumns.UpdatedAt,
+	)); err != nil {
+		return model.Product{}, err
+	}
+
+	return toProduct(product), nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Revoke revokes a live API key, returning a not found error when there is
+// none with this id.
+func (i APIKeyRepositoryImpl) Revoke(ctx context.Context, id int64) error {
+	now := time.Now()
+	rowsAff, err := models.APIKeys(
+		models.APIKeyWhere.ID.EQ(id),
+		models.APIKeyWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, i.db, models.M{
+		models.APIKeyColumns.RevokedAt: now,
+		models.APIKeyColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return apiKeyErr(sql.ErrNoRows)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Schedule stores a future-dated price change under a new sonyflake id, it
+// stays pending until applied.
+func (i PriceChangeRepositoryImpl) Schedule(ctx context.Context, change model.PriceChange) (_ model.PriceChange, err error) {
+	ctx, span := tracer.Start(ctx, "PriceChangeRepository.Schedule")
+	defer tracing.End(span, &err)
+
+	newID, err := i.idsnf.NextID()
+	if err != nil {
+		return model.PriceChange{}, fmt.Errorf("%w", err)
+	}
+	c := models.PriceChange{
+		ID:                int64(newID),
+		ProductID:         change.ProductID,
+		Amount:            change.Price.Amount,
+		Currency:          change.Price.Currency,
+		Reason:            change.Reason,
+		ChangedByUserID:   nullID(change.ChangedBy.UserID),
+		ChangedByAPIKeyID: nullID(change.ChangedBy.APIKeyID),
+		EffectiveAt:       change.EffectiveAt,
+	}
+
+	// created_at is set by the generated Insert
+	if err := c.Insert(ctx, i.db, boil.Infer()); err != nil {
+		return model.PriceChange{}, priceChangeErr(err)
+	}
+
+	return toPriceChange(&c), nil
+}
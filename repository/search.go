@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ts_headline delimits the matches with these private use characters, which
+// markSnippet turns into <mark> once the name is HTML-escaped. They are
+// stripped from the name first so a stored name cannot forge a match.
+const (
+	matchStart = "\uE000"
+	matchStop  = "\uE001"
+)
+
+const (
+	searchHighlight = "StartSel=" + matchStart + ", StopSel=" + matchStop + ", HighlightAll=true"
+
+	fullTextSearchQuery = `SELECT "product".*,
+	ts_rank("product"."search_vector", q) AS rank,
+	ts_headline('english', translate("product"."name", '` + matchStart + matchStop + `', ''), q, '` + searchHighlight + `') AS snippet
+FROM "product", to_tsquery('english', $1) q
+WHERE "product"."deleted_at" IS NULL AND "product"."search_vector" @@ q
+ORDER BY rank DESC, "product"."id"
+LIMIT $2 OFFSET $3`
+
+	fullTextCountQuery = `SELECT count(*)
+FROM "product", to_tsquery('english', $1) q
+WHERE "product"."deleted_at" IS NULL AND "product"."search_vector" @@ q`
+
+	// the trigram index on name serves the ILIKE
+	trigramSearchQuery = `SELECT "product".*,
+	similarity("product"."name", $1) AS rank,
+	"product"."name" AS snippet
+FROM "product"
+WHERE "product"."deleted_at" IS NULL AND "product"."name" ILIKE $2
+ORDER BY rank DESC, "product"."id"
+LIMIT $3 OFFSET $4`
+
+	trigramCountQuery = `SELECT count(*)
+FROM "product"
+WHERE "product"."deleted_at" IS NULL AND "product"."name" ILIKE $1`
+)
+
+type searchRow struct {
+	models.Product `boil:",bind"`
+	Rank           float64 `boil:"rank"`
+	Snippet        string  `boil:"snippet"`
+}
+
+type countRow struct {
+	Count int64 `boil:"count"`
+}
+
+func (i ProductRepositoryImpl) Search(ctx context.Context, query model.SearchQuery, page model.Page) (_ model.SearchPage, err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.Search")
+	defer tracing.End(span, &err)
+
+	var (
+		rows  []searchRow
+		count countRow
+	)
+
+	if query.Fuzzy {
+		pattern := "%" + likeEscaper.Replace(query.Text) + "%"
+
+		if err := queries.Raw(trigramCountQuery, pattern).Bind(ctx, i.db, &count); err != nil {
+			return model.SearchPage{}, err
+		}
+		if err := queries.Raw(trigramSearchQuery, query.Text, pattern, page.Limit, page.Offset).Bind(ctx, i.db, &rows); err != nil {
+			return model.SearchPage{}, err
+		}
+
+		for i := range rows {
+			rows[i].Snippet = highlight(rows[i].Name, query.Text)
+		}
+	} else {
+		tsQuery := toTSQuery(query.Text, query.Prefix)
+		if tsQuery == "" {
+			return model.SearchPage{Results: []model.SearchResult{}}, nil
+		}
+
+		if err := queries.Raw(fullTextCountQuery, tsQuery).Bind(ctx, i.db, &count); err != nil {
+			return model.SearchPage{}, err
+		}
+		if err := queries.Raw(fullTextSearchQuery, tsQuery, page.Limit, page.Offset).Bind(ctx, i.db, &rows); err != nil {
+			return model.SearchPage{}, err
+		}
+
+		for i := range rows {
+			rows[i].Snippet = markSnippet(rows[i].Snippet)
+		}
+	}
+
+	results := make([]model.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = model.SearchResult{
+			Product: toProduct(&row.Product),
+			Rank:    row.Rank,
+			Snippet: row.Snippet,
+		}
+	}
+
+	return model.SearchPage{
+		Results: results,
+		Total:   count.Count,
+	}, nil
+}
+
+// toTSQuery turns free text into a tsquery matching all of its words, the
+// last one as a prefix if asked. Anything but letters and digits is dropped
+// so user input can't inject tsquery operators.
+func toTSQuery(text string, prefix bool) string {
+	terms := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) == 0 {
+		return ""
+	}
+
+	if prefix {
+		terms[len(terms)-1] += ":*"
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// highlight HTML-escapes name and wraps case-insensitive occurrences of term
+// with <mark>. Matching happens before escaping so a term like "&" still
+// matches.
+func highlight(name, term string) string {
+	if term == "" {
+		return html.EscapeString(name)
+	}
+	rgx := regexp.MustCompile("(?i)" + regexp.QuoteMeta(term))
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range rgx.FindAllStringIndex(name, -1) {
+		b.WriteString(html.EscapeString(name[last:loc[0]]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(name[loc[0]:loc[1]]))
+		b.WriteString("</mark>")
+		last = loc[1]
+	}
+	b.WriteString(html.EscapeString(name[last:]))
+	return b.String()
+}
+
+// markSnippet HTML-escapes a ts_headline snippet and turns its match
+// delimiters into <mark>.
+func markSnippet(snippet string) string {
+	return strings.NewReplacer(matchStart, "<mark>", matchStop, "</mark>").Replace(html.EscapeString(snippet))
+}
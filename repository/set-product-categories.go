@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"chi-demo/tracing"
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// setProductCategoriesQuery replaces the categories of a product in a
+// single statement, keeping the assignments that stay.
+const setProductCategoriesQuery = `WITH removed AS (
+	DELETE FROM "product_category"
+	WHERE "product_id" = $1 AND NOT ("category_id" = ANY($2::bigint[]))
+)
+INSERT INTO "product_category" ("product_id", "category_id")
+SELECT $1::bigint, "category_id" FROM unnest($2::bigint[]) AS "category_id"
+ON CONFLICT DO NOTHING`
+
+// SetProductCategories assigns a product to exactly the given categories,
+// an unknown category is a validation error.
+func (i CategoryRepositoryImpl) SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64) (err error) {
+	ctx, span := tracer.Start(ctx, "CategoryRepository.SetProductCategories")
+	defer tracing.End(span, &err)
+
+	// pq sends a nil slice as NULL, which would keep every assignment
+	if categoryIDs == nil {
+		categoryIDs = []int64{}
+	}
+	if _, err := queries.Raw(setProductCategoriesQuery, productID, pq.Array(categoryIDs)).ExecContext(ctx, i.db); err != nil {
+		return categoryErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+truncate table "user" cascade;
+insert into "user" (id, username, password_hash, role, created_at, updated_at) values (1, 'admin', 'hash', 'admin', now(), now());
+insert into api_key (id, name, key_hash, scopes, created_by, revoked_at, created_at, updated_at) values
+    (1, 'revoked', 'hash-1', '{products:read}', 1, '2023-01-01 00:00:00+00', now(), now()),
+    (2, 'batch', 'hash-2', '{products:read,products:write}', 1, null, now(), now());
@@ -0,0 +1,20 @@
+truncate table "product" cascade;
+truncate table "category" cascade;
+insert into "product" (id, name, price, created_at, updated_at) values
+    (1, 'running shoes', 1000, now(), now()),
+    (2, 't-shirt', 2000, now(), now()),
+    (3, 'rake', 3000, now(), now());
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (4, 'sandals', 4000, now(), now(), now());
+-- clothing > shoes > running, clothing > shirts, garden
+insert into category (id, parent_id, name, created_at, updated_at) values
+    (1, null, 'clothing', now(), now()),
+    (2, 1, 'shoes', now(), now()),
+    (3, 2, 'running', now(), now()),
+    (4, 1, 'shirts', now(), now()),
+    (5, null, 'garden', now(), now());
+insert into product_category (product_id, category_id) values
+    (1, 3),
+    (1, 5),
+    (2, 4),
+    (3, 5),
+    (4, 2);
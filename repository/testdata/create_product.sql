@@ -1 +1 @@
-truncate table "product";
+truncate table "product" cascade;
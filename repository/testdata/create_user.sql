@@ -0,0 +1,2 @@
+truncate table "user" cascade;
+insert into "user" (id, username, password_hash, created_at, updated_at) values (1, 'taken', 'hash', now(), now());
@@ -0,0 +1,4 @@
+truncate table exchange_rate;
+insert into exchange_rate (currency, base, rate, updated_at) values
+    ('EUR', 'USD', 0.92, '2024-01-01 00:00:00+00'),
+    ('JPY', 'USD', 151.2, '2024-01-02 00:00:00+00');
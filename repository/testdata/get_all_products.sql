@@ -1,3 +1,4 @@
-truncate table "product";
+truncate table "product" cascade;
 insert into "product" (id, name, price, created_at, updated_at) values (1, 'test1', 1, now(), now());
 insert into "product" (id, name, price, created_at, updated_at) values (2, 'test2', 2, now(), now());
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (3, 'test3', 3, now(), now(), now());
@@ -0,0 +1,2 @@
+truncate table "user" cascade;
+insert into "user" (id, username, password_hash, role, created_at, updated_at) values (1, 'test', 'hash', 'editor', now(), now());
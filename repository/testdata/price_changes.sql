@@ -0,0 +1,11 @@
+truncate table "product" cascade;
+truncate table "user" cascade;
+insert into "user" (id, username, password_hash, role, created_at, updated_at) values (1, 'admin', 'hash', 'admin', now(), now());
+insert into "product" (id, name, price, created_at, updated_at) values (1, 'test', 1000, now(), now());
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (2, 'deleted', 2000, now(), now(), now());
+insert into price_change (id, product_id, amount, currency, previous_amount, previous_currency, reason, changed_by_user_id, effective_at, applied_at, created_at) values
+    (1, 1, 1000, 'USD', 1200, 'USD', 'launch', 1, '2024-01-01 00:00:00+00', '2024-01-01 00:00:00+00', '2024-01-01 00:00:00+00');
+insert into price_change (id, product_id, amount, currency, reason, changed_by_user_id, effective_at, created_at) values
+    (2, 1, 800, 'USD', 'summer sale', 1, '2024-06-01 00:00:00+00', '2024-05-01 00:00:00+00'),
+    (3, 1, 1000, 'USD', 'end of summer sale', 1, '2024-09-01 00:00:00+00', '2024-05-01 00:00:00+00'),
+    (4, 2, 1500, 'USD', 'clearance', 1, '2024-06-01 00:00:00+00', '2024-05-01 00:00:00+00');
@@ -0,0 +1,6 @@
+truncate table "product" cascade;
+insert into "product" (id, name, price, created_at, updated_at) values (1, 'test', 1000, now(), now());
+insert into product_price (id, product_id, currency, amount, valid_from, valid_to, created_at) values
+    (1, 1, 'EUR', 900, '2024-01-01 00:00:00+00', '2024-02-01 00:00:00+00', now()),
+    (2, 1, 'EUR', 950, '2024-02-01 00:00:00+00', null, now()),
+    (3, 1, 'CHF', 895, '2024-01-01 00:00:00+00', null, now());
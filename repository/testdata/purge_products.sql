@@ -0,0 +1,4 @@
+truncate table "product" cascade;
+insert into "product" (id, name, price, created_at, updated_at) values (1, 'live', 1, now(), now());
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (2, 'recently deleted', 2, now(), now(), now());
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (3, 'long deleted', 3, now() - interval '60 days', now() - interval '60 days', now() - interval '60 days');
@@ -0,0 +1,6 @@
+truncate table "user" cascade;
+insert into "user" (id, username, password_hash, created_at, updated_at) values (1, 'test', 'hash', now(), now());
+insert into refresh_token (id, user_id, family_id, token_hash, expires_at, revoked_at, created_at, updated_at) values
+    (10, 1, 10, 'hash-10', '2100-01-01 00:00:00+00', '2023-01-01 00:00:00+00', now(), now()),
+    (11, 1, 10, 'hash-11', '2100-01-01 00:00:00+00', null, now(), now()),
+    (20, 1, 20, 'hash-20', '2100-01-01 00:00:00+00', null, now(), now());
@@ -0,0 +1,3 @@
+truncate table "product" cascade;
+insert into "product" (id, name, price, created_at, updated_at) values (1, 'test', 1, now(), now());
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (2, 'deleted', 2, now(), now(), now());
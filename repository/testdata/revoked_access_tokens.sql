@@ -0,0 +1,4 @@
+truncate table revoked_access_token;
+insert into revoked_access_token (jti, expires_at, created_at) values
+    ('denied', '2100-01-01 00:00:00+00', now()),
+    ('expired', '2000-01-01 00:00:00+00', now());
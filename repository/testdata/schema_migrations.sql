@@ -0,0 +1,2 @@
+truncate table schema_migrations;
+insert into schema_migrations (version, dirty) values (5, true);
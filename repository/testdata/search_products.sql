@@ -0,0 +1,6 @@
+truncate table "product" cascade;
+insert into "product" (id, name, price, created_at, updated_at) values (1, 'running shoes', 1, now(), now());
+insert into "product" (id, name, price, created_at, updated_at) values (2, 'shoe polish', 2, now(), now());
+insert into "product" (id, name, price, created_at, updated_at) values (3, 'tennis racket', 3, now(), now());
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (4, 'deleted shoes', 4, now(), now(), now());
+insert into "product" (id, name, price, created_at, updated_at) values (5, '<script>alert(1)</script> bag', 5, now(), now());
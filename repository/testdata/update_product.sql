@@ -0,0 +1,5 @@
+truncate table "product" cascade;
+truncate table "user" cascade;
+insert into "user" (id, username, password_hash, role, created_at, updated_at) values (1, 'admin', 'hash', 'admin', now(), now());
+insert into "product" (id, name, price, created_at, updated_at) values (1, 'test', 1, now() - interval '1 day', now() - interval '1 day');
+insert into "product" (id, name, price, created_at, updated_at, deleted_at) values (2, 'deleted', 2, now(), now(), now());
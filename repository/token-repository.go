@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sony/sonyflake"
+)
+
+// ErrAlreadyRevoked is returned when revoking a refresh token that has
+// already been revoked, e.g. by a concurrent rotation.
+var ErrAlreadyRevoked = errors.New("refresh token already revoked")
+
+type TokenRepositoryImpl struct {
+	db    db.ContextExecutor
+	idsnf *sonyflake.Sonyflake
+}
+
+type TokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token model.RefreshToken) (model.RefreshToken, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (model.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, id int64) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID int64) error
+	DenyAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenDenied(ctx context.Context, jti string) (bool, error)
+}
+
+func NewTokenRepository(db db.ContextExecutor) (TokenRepository, error) {
+	flake, err := newIDGenerator()
+	if err != nil {
+		return nil, err
+	}
+
+	return TokenRepositoryImpl{
+		db:    db,
+		idsnf: flake,
+	}, nil
+}
+
+func toRefreshToken(t *models.RefreshToken) model.RefreshToken {
+	return model.RefreshToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		FamilyID:  t.FamilyID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		RevokedAt: t.RevokedAt.Time,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+// refreshTokenErr turns the database errors of the refresh token queries into domain errors.
+func refreshTokenErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperr.NotFound("refresh token", err)
+	}
+	return err
+}
@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/repository/testdata"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenImpl_CreateRefreshToken(t *testing.T) {
+	type args struct {
+		givenFamilyID int64
+		expDBFailed   bool
+		expErr        error
+	}
+
+	tcs := map[string]args{
+		"success: new family": {},
+		"success: existing family": {
+			givenFamilyID: 10,
+		},
+		"error: db failed": {
+			expDBFailed: true,
+			expErr:      errors.New("models: unable to insert into refresh_token: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewTokenRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewTokenRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/refresh_tokens.sql")
+
+				// When
+				result, err := repo.CreateRefreshToken(ctx, model.RefreshToken{
+					UserID:    1,
+					FamilyID:  tc.givenFamilyID,
+					TokenHash: "hash-new",
+					ExpiresAt: time.Now().Add(time.Hour),
+				})
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.NotZero(t, result.ID)
+					if tc.givenFamilyID == 0 {
+						require.Equal(t, result.ID, result.FamilyID)
+					} else {
+						require.Equal(t, tc.givenFamilyID, result.FamilyID)
+					}
+					require.Equal(t, "hash-new", result.TokenHash)
+				}
+			})
+		})
+	}
+}
+
+func TestTokenImpl_GetRefreshTokenByHash(t *testing.T) {
+	type args struct {
+		givenHash   string
+		expDBFailed bool
+		expID       int64
+		expRevoked  bool
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenHash: "hash-11",
+			expID:     11,
+		},
+		"success: revoked": {
+			givenHash:  "hash-10",
+			expID:      10,
+			expRevoked: true,
+		},
+		"error: not found": {
+			givenHash: "unknown",
+			expErr:    apperr.NotFound("refresh token", sql.ErrNoRows),
+		},
+		"error: db failed": {
+			givenHash:   "hash-11",
+			expDBFailed: true,
+			expErr:      errors.New("models: failed to execute a one query for refresh_token: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewTokenRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewTokenRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/refresh_tokens.sql")
+
+				// When
+				result, err := repo.GetRefreshTokenByHash(ctx, tc.givenHash)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.expID, result.ID)
+					require.Equal(t, int64(1), result.UserID)
+					require.Equal(t, int64(10), result.FamilyID)
+					require.Equal(t, tc.expRevoked, !result.RevokedAt.IsZero())
+				}
+			})
+		})
+	}
+}
+
+func TestTokenImpl_RevokeRefreshToken(t *testing.T) {
+	type args struct {
+		givenID     int64
+		expDBFailed bool
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID: 11,
+		},
+		"error: already revoked": {
+			givenID: 10,
+			expErr:  ErrAlreadyRevoked,
+		},
+		"error: db failed": {
+			givenID:     11,
+			expDBFailed: true,
+			expErr:      errors.New("models: unable to update all for refresh_token: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewTokenRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewTokenRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/refresh_tokens.sql")
+
+				// When
+				err = repo.RevokeRefreshToken(ctx, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					token, err := models.FindRefreshToken(ctx, tx, tc.givenID)
+					require.NoError(t, err)
+					require.True(t, token.RevokedAt.Valid)
+				}
+			})
+		})
+	}
+}
+
+func TestTokenImpl_RevokeRefreshTokenFamily(t *testing.T) {
+	type args struct {
+		givenFamilyID int64
+		expDBFailed   bool
+		expErr        error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenFamilyID: 10,
+		},
+		"error: db failed": {
+			givenFamilyID: 10,
+			expDBFailed:   true,
+			expErr:        errors.New("models: unable to update all for refresh_token: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewTokenRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewTokenRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/refresh_tokens.sql")
+
+				// When
+				err = repo.RevokeRefreshTokenFamily(ctx, tc.givenFamilyID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					live, err := models.RefreshTokens(models.RefreshTokenWhere.RevokedAt.IsNull()).All(ctx, tx)
+					require.NoError(t, err)
+					// only the other family is left
+					require.Len(t, live, 1)
+					require.Equal(t, int64(20), live[0].ID)
+				}
+			})
+		})
+	}
+}
+
+func TestTokenImpl_DenyAccessToken(t *testing.T) {
+	type args struct {
+		givenJTI    string
+		expDBFailed bool
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenJTI: "new",
+		},
+		"success: already denied": {
+			givenJTI: "denied",
+		},
+		"error: db failed": {
+			givenJTI:    "new",
+			expDBFailed: true,
+			expErr:      errors.New("models: unable to delete all from revoked_access_token: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewTokenRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewTokenRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/revoked_access_tokens.sql")
+
+				// When
+				err = repo.DenyAccessToken(ctx, tc.givenJTI, time.Now().Add(time.Minute))
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					denied, err := repo.IsAccessTokenDenied(ctx, tc.givenJTI)
+					require.NoError(t, err)
+					require.True(t, denied)
+					// expired entries are cleaned up
+					denied, err = repo.IsAccessTokenDenied(ctx, "expired")
+					require.NoError(t, err)
+					require.False(t, denied)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// updateCategoryQuery renames and moves a category. The category_cycle_check
+// trigger rejects a parent inside the category's own subtree, concurrent
+// moves included.
+const updateCategoryQuery = `UPDATE "category" SET "parent_id" = $2, "name" = $3, "updated_at" = $4
+WHERE "id" = $1
+RETURNING "category".*`
+
+// Update sets the name and the parent of a category, moving it with its
+// whole subtree.
+func (i CategoryRepositoryImpl) Update(ctx context.Context, category model.Category) (_ model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryRepository.Update")
+	defer tracing.End(span, &err)
+
+	var c models.Category
+	err = queries.Raw(updateCategoryQuery, category.ID, nullID(category.ParentID), category.Name, time.Now()).Bind(ctx, i.db, &c)
+	if err != nil {
+		return model.Category{}, categoryErr(err)
+	}
+
+	return toCategory(&c), nil
+}
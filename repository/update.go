@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"chi-demo/tracing"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// updateProductQuery updates a live product and records a price change in
+// the price history when its price differs, in a single statement so the
+// history cannot miss a change.
+const updateProductQuery = `WITH previous AS (
+	SELECT "id", "price", "currency" FROM "product"
+	WHERE "id" = $1 AND "deleted_at" IS NULL
+	FOR UPDATE
+), updated AS (
+	UPDATE "product" SET "name" = $2, "price" = $3, "currency" = $4, "updated_at" = $5
+	FROM previous WHERE "product"."id" = previous."id"
+	RETURNING "product".*
+), recorded AS (
+	INSERT INTO "price_change" ("id", "product_id", "amount", "currency", "previous_amount", "previous_currency",
+		"reason", "changed_by_user_id", "changed_by_api_key_id", "effective_at", "applied_at", "created_at")
+	SELECT $6, previous."id", $3, $4, previous."price", previous."currency", $7, $8, $9, $5, $5, $5
+	FROM previous
+	WHERE (previous."price", previous."currency") IS DISTINCT FROM ($3::bigint, $4::char(3))
+)
+SELECT * FROM updated`
+
+// Update replaces the name and price of a live product, note says who
+// changes the price and why.
+func (i ProductRepositoryImpl) Update(ctx context.Context, product model.Product, note model.ChangeNote) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductRepository.Update")
+	defer tracing.End(span, &err)
+
+	changeID, err := i.idsnf.NextID()
+	if err != nil {
+		return model.Product{}, fmt.Errorf("%w", err)
+	}
+	// timestamptz keeps microseconds
+	now := time.Now().Truncate(time.Microsecond)
+
+	var p models.Product
+	err = queries.Raw(updateProductQuery,
+		product.ID,
+		product.Name,
+		product.Price.Amount,
+		product.Price.Currency,
+		now,
+		int64(changeID),
+		note.Reason,
+		nullID(note.ChangedBy.UserID),
+		nullID(note.ChangedBy.APIKeyID),
+	).Bind(ctx, i.db, &p)
+	if err != nil {
+		return model.Product{}, productErr(err)
+	}
+
+	return toProduct(&p), nil
+}
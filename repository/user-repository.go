@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	models "chi-demo/my_models"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sony/sonyflake"
+)
+
+// ErrUsernameTaken is returned when creating a user whose username exists.
+var ErrUsernameTaken = apperr.Conflict("username already taken", nil)
+
+type UserRepositoryImpl struct {
+	db    db.ContextExecutor
+	idsnf *sonyflake.Sonyflake
+}
+
+type UserRepository interface {
+	Create(ctx context.Context, user model.User) (model.User, error)
+	GetByUsername(ctx context.Context, username string) (model.User, error)
+	GetByID(ctx context.Context, id int64) (model.User, error)
+}
+
+func NewUserRepository(db db.ContextExecutor) (UserRepository, error) {
+	flake, err := newIDGenerator()
+	if err != nil {
+		return nil, err
+	}
+
+	return UserRepositoryImpl{
+		db:    db,
+		idsnf: flake,
+	}, nil
+}
+
+func toUser(u *models.User) model.User {
+	return model.User{
+		ID:           u.ID,
+		Username:     u.Username,
+		PasswordHash: u.PasswordHash,
+		Role:         model.Role(u.Role),
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+	}
+}
+
+// userErr turns the database errors of the user queries into domain errors.
+func userErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperr.NotFound("user", err)
+	}
+	return err
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/db"
+	"chi-demo/model"
+	"chi-demo/repository/testdata"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserImpl_Create(t *testing.T) {
+	type args struct {
+		givenUsername string
+		expDBFailed   bool
+		expErr        error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenUsername: "test",
+		},
+		"error: username taken": {
+			givenUsername: "taken",
+			expErr:        ErrUsernameTaken,
+		},
+		"error: db failed": {
+			givenUsername: "test",
+			expDBFailed:   true,
+			expErr:        errors.New("models: unable to insert into user: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewUserRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewUserRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/create_user.sql")
+
+				// When
+				result, err := repo.Create(ctx, model.User{
+					Username:     tc.givenUsername,
+					PasswordHash: "hash",
+					Role:         model.RoleViewer,
+				})
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					require.NotZero(t, result.ID)
+					require.NotZero(t, result.CreatedAt)
+					require.Equal(t, tc.givenUsername, result.Username)
+					require.Equal(t, "hash", result.PasswordHash)
+					require.Equal(t, model.RoleViewer, result.Role)
+				}
+			})
+		})
+	}
+}
+
+func TestUserImpl_GetByUsername(t *testing.T) {
+	type args struct {
+		givenUsername string
+		expDBFailed   bool
+		expRs         model.User
+		expErr        error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenUsername: "test",
+			expRs: model.User{
+				ID:           1,
+				Username:     "test",
+				PasswordHash: "hash",
+				Role:         model.RoleEditor,
+			},
+		},
+		"error: not found": {
+			givenUsername: "unknown",
+			expErr:        apperr.NotFound("user", sql.ErrNoRows),
+		},
+		"error: db failed": {
+			givenUsername: "test",
+			expDBFailed:   true,
+			expErr:        errors.New("models: failed to execute a one query for user: bind failed to execute query: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewUserRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewUserRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/get_user_by_username.sql")
+
+				// When
+				result, err := repo.GetByUsername(ctx, tc.givenUsername)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					if !cmp.Equal(tc.expRs, result,
+						cmpopts.IgnoreFields(model.User{}, "CreatedAt", "UpdatedAt")) {
+						t.Errorf("\n user mismatched. \n expected: %+v \n got: %+v \n diff: %+v", tc.expRs, result,
+							cmp.Diff(tc.expRs, result, cmpopts.IgnoreFields(model.User{}, "CreatedAt", "UpdatedAt")))
+						t.FailNow()
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestUserImpl_GetByID(t *testing.T) {
+	type args struct {
+		givenID     int64
+		expDBFailed bool
+		expRs       model.User
+		expErr      error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenID: 1,
+			expRs: model.User{
+				ID:           1,
+				Username:     "test",
+				PasswordHash: "hash",
+				Role:         model.RoleEditor,
+			},
+		},
+		"error: not found": {
+			givenID: 2,
+			expErr:  apperr.NotFound("user", sql.ErrNoRows),
+		},
+		"error: db failed": {
+			givenID:     1,
+			expDBFailed: true,
+			expErr:      errors.New("models: unable to select from user: sql: database is closed"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			TestWithTxDB(t, func(tx db.ContextExecutor) {
+				// Given
+				repo, err := NewUserRepository(tx)
+				require.NoError(t, err)
+				if tc.expDBFailed {
+					dbMock, _, _ := sqlmock.New()
+					dbMock.Close()
+					repo, err = NewUserRepository(dbMock)
+					require.NoError(t, err)
+				}
+				testdata.LoadTestSQLFile(t, tx, "testdata/get_user_by_id.sql")
+
+				// When
+				result, err := repo.GetByID(ctx, tc.givenID)
+
+				// Then
+				if tc.expErr != nil {
+					require.EqualError(t, err, tc.expErr.Error())
+				} else {
+					require.NoError(t, err)
+					if !cmp.Equal(tc.expRs, result,
+						cmpopts.IgnoreFields(model.User{}, "CreatedAt", "UpdatedAt")) {
+						t.Errorf("\n user mismatched. \n expected: %+v \n got: %+v \n diff: %+v", tc.expRs, result,
+							cmp.Diff(tc.expRs, result, cmpopts.IgnoreFields(model.User{}, "CreatedAt", "UpdatedAt")))
+						t.FailNow()
+					}
+				}
+			})
+		})
+	}
+}
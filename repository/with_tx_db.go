@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"chi-demo/config"
 	"chi-demo/db"
 	"context"
 	"database/sql"
@@ -12,7 +13,7 @@ import (
 func TestWithTxDB(t *testing.T, testFunc func(tx db.ContextExecutor)) {
 	ctx := context.Background()
 
-	db, err := db.Init()
+	db, err := db.Init(config.Default().Database)
 	require.NoError(t, err)
 
 	tx, err := db.BeginTx(ctx, &sql.TxOptions{})
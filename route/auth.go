@@ -0,0 +1,30 @@
+package route
+
+import (
+	"chi-demo/auth"
+	"chi-demo/config"
+	"chi-demo/handler"
+	"net/http"
+)
+
+// authenticate accepts either an API key in X-API-Key, when enabled, or a
+// bearer JWT, and puts the resulting principal into the request context.
+func authenticate(features config.FeaturesConfig, tokenAuth *auth.JWTAuth, userHandler handler.UserHandler, apiKeyHandler handler.APIKeyHandler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		// Seek, verify and validate JWT tokens, then reject missing, invalid
+		// or revoked tokens through the handler errors
+		withToken := tokenAuth.Verifier()(
+			userHandler.Denylist(
+				handler.TokenPrincipal(next)))
+
+		withAPIKey := apiKeyHandler.Authenticate(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if features.APIKeys && r.Header.Get(handler.APIKeyHeader) != "" {
+				withAPIKey.ServeHTTP(w, r)
+				return
+			}
+			withToken.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,45 +1,117 @@
 package route
 
 import (
+	"chi-demo/auth"
+	"chi-demo/config"
 	"chi-demo/handler"
-	"fmt"
+	"chi-demo/model"
 	"net/http"
 
 	"github.com/go-chi/chi"
-	"github.com/go-chi/jwtauth/v5"
 )
 
-var tokenAuth *jwtauth.JWTAuth
-
-func init() {
-	tokenAuth = jwtauth.New("HS256", []byte("secret"), nil)
-	_, tokenString, _ := tokenAuth.Encode(map[string]interface{}{"user_id": 123})
-	fmt.Printf("DEBUG: a sample jwt is %s\n\n", tokenString)
-}
-
-func InitRouter(r *chi.Mux, productHandler handler.ProductHandler) {
+func InitRouter(r *chi.Mux, cfg config.Config, tokenAuth *auth.JWTAuth, productHandler handler.ProductHandler, priceHandler handler.PriceHandler, categoryHandler handler.CategoryHandler, userHandler handler.UserHandler, apiKeyHandler handler.APIKeyHandler, healthHandler handler.HealthHandler, metricsHandler http.Handler) {
 	// Protected routes
 	r.Group(func(r chi.Router) {
-		// Seek, verify and validate JWT tokens
-		r.Use(jwtauth.Verifier(tokenAuth))
+		// Accept either a JWT or an API key
+		r.Use(authenticate(cfg.Features, tokenAuth, userHandler, apiKeyHandler))
+
+		r.Post("/auth/logout", userHandler.Logout())
+
+		r.Group(func(r chi.Router) {
+			r.Use(handler.RequireScope(model.ScopeProductsRead))
+
+			r.Get("/products/{id}", productHandler.GetOne())
+
+			r.Get("/products", productHandler.GetProducts())
+
+			r.Get("/products/search", productHandler.SearchProducts())
+
+			r.Get("/products/{id}/prices", priceHandler.GetPrices())
+
+			r.Get("/products/{id}/prices/{priceID}", priceHandler.GetPrice())
+
+			r.Get("/products/{id}/price-history", priceHandler.GetPriceHistory())
+
+			r.Get("/products/{id}/price-changes/{changeID}", priceHandler.GetPriceChange())
+
+			r.Get("/products/{id}/categories", categoryHandler.GetProductCategories())
+
+			r.Get("/categories", categoryHandler.GetCategories())
+
+			r.Get("/categories/{id}", categoryHandler.GetCategory())
+
+			r.Get("/categories/{id}/products", categoryHandler.GetCategoryProducts())
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(handler.RequireScope(model.ScopeProductsWrite))
+
+			r.Post("/products", productHandler.CreateProduct())
+
+			r.Put("/products/{id}", productHandler.UpdateProduct())
+
+			r.Patch("/products/{id}", productHandler.PatchProduct())
 
-		// Handle valid / invalid tokens
-		r.Use(jwtauth.Authenticator)
+			r.Delete("/products/{id}", productHandler.DeleteProduct())
 
-		r.Get("/products/{id}", productHandler.GetOne())
+			r.Post("/products/{id}/restore", productHandler.RestoreProduct())
 
-		r.Get("/products", productHandler.GetProducts())
+			r.Post("/products/{id}/prices", priceHandler.CreatePrice())
 
-		r.Post("/product", productHandler.CreateProduct())
+			r.Delete("/products/{id}/prices/{priceID}", priceHandler.DeletePrice())
 
-		r.Delete("/products/{id}", productHandler.DeleteProduct())
+			r.Post("/products/{id}/price-changes", priceHandler.SchedulePriceChange())
 
+			r.Delete("/products/{id}/price-changes/{changeID}", priceHandler.CancelPriceChange())
+
+			r.Put("/products/{id}/categories", categoryHandler.SetProductCategories())
+
+			r.Post("/categories", categoryHandler.CreateCategory())
+
+			r.Put("/categories/{id}", categoryHandler.UpdateCategory())
+
+			r.Delete("/categories/{id}", categoryHandler.DeleteCategory())
+		})
+
+		// Admin only
+		r.Group(func(r chi.Router) {
+			r.Use(handler.RequireRole(model.RoleAdmin))
+
+			r.Post("/products/purge", productHandler.PurgeProducts())
+
+			r.Get("/exchange-rates", priceHandler.GetExchangeRates())
+
+			r.Put("/exchange-rates", priceHandler.SetExchangeRates())
+
+			if cfg.Features.APIKeys {
+				r.Post("/api-keys", apiKeyHandler.CreateAPIKey())
+
+				r.Get("/api-keys", apiKeyHandler.GetAPIKeys())
+
+				r.Delete("/api-keys/{id}", apiKeyHandler.RevokeAPIKey())
+			}
+		})
 	})
 
 	r.Group(func(r chi.Router) {
 		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 			w.Write([]byte("root."))
 		})
+
+		r.Get("/healthz", healthHandler.Healthz())
+
+		r.Get("/readyz", healthHandler.Readyz())
+
+		r.Method(http.MethodGet, "/metrics", metricsHandler)
+
+		r.Post("/auth/register", userHandler.Register())
+
+		r.Post("/auth/login", userHandler.Login())
+
+		r.Post("/auth/refresh", userHandler.Refresh())
+
+		r.Get("/.well-known/jwks.json", handler.JWKS(tokenAuth.PublicKeys()))
 	})
 
 }
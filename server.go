@@ -0,0 +1,63 @@
+package main
+
+import (
+	"chi-demo/config"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+func newServer(cfg config.ServerConfig, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Port),
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+}
+
+// serve runs server on listener until ctx is done. It then calls drain so
+// the readiness probe fails, keeps accepting requests for the readiness
+// drain delay while load balancers stop routing to it, and finally stops
+// accepting connections and waits up to the shutdown timeout for in-flight
+// requests.
+func serve(ctx context.Context, server *http.Server, listener net.Listener, cfg config.ServerConfig, drain func()) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	drain()
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(cfg.ReadinessDrainDelay):
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		// the deadline passed, drop whatever is left
+		server.Close()
+		return fmt.Errorf("shutdown: %w", err)
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
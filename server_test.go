@@ -0,0 +1,125 @@
+package main
+
+import (
+	"chi-demo/config"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServe(t *testing.T) {
+	type args struct {
+		givenHandlerDelay    time.Duration
+		givenShutdownTimeout time.Duration
+		givenDrainDelay      time.Duration
+		expBody              string
+		expErr               string
+	}
+
+	tcs := map[string]args{
+		"success - drains in-flight request": {
+			givenHandlerDelay:    100 * time.Millisecond,
+			givenShutdownTimeout: time.Second,
+			expBody:              "done",
+		},
+		"success - serves during the drain delay": {
+			givenShutdownTimeout: time.Second,
+			givenDrainDelay:      200 * time.Millisecond,
+			expBody:              "done",
+		},
+		"err - shutdown deadline exceeded": {
+			givenHandlerDelay:    time.Second,
+			givenShutdownTimeout: 50 * time.Millisecond,
+			expErr:               "shutdown: context deadline exceeded",
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			started := make(chan struct{})
+			var once sync.Once
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				once.Do(func() { close(started) })
+				time.Sleep(tc.givenHandlerDelay)
+				w.Write([]byte("done"))
+			})
+			cfg := config.Default().Server
+			cfg.ShutdownTimeout = tc.givenShutdownTimeout
+			cfg.ReadinessDrainDelay = tc.givenDrainDelay
+			server := newServer(cfg, handler)
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			drained := make(chan struct{})
+			serveErr := make(chan error, 1)
+			go func() {
+				serveErr <- serve(ctx, server, listener, cfg, func() { close(drained) })
+			}()
+
+			type result struct {
+				body string
+				err  error
+			}
+			resCh := make(chan result, 1)
+			go func() {
+				res, err := http.Get("http://" + listener.Addr().String())
+				if err != nil {
+					resCh <- result{err: err}
+					return
+				}
+				defer res.Body.Close()
+				body, err := io.ReadAll(res.Body)
+				resCh <- result{body: string(body), err: err}
+			}()
+
+			// When
+			<-started
+			cancel()
+			<-drained
+			// a new connection is still accepted while the readiness probe
+			// fails
+			if tc.givenDrainDelay > 0 {
+				res, err := http.Get("http://" + listener.Addr().String())
+				require.NoError(t, err)
+				res.Body.Close()
+			}
+			err = <-serveErr
+
+			// Then
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				res := <-resCh
+				require.NoError(t, res.err)
+				require.Equal(t, tc.expBody, res.body)
+			}
+		})
+	}
+}
+
+func TestNewServer(t *testing.T) {
+	// Given
+	cfg := config.Default().Server
+
+	// When
+	server := newServer(cfg, http.NotFoundHandler())
+
+	// Then
+	require.Equal(t, ":3333", server.Addr)
+	require.Equal(t, cfg.ReadTimeout, server.ReadTimeout)
+	require.Equal(t, cfg.ReadHeaderTimeout, server.ReadHeaderTimeout)
+	require.Equal(t, cfg.WriteTimeout, server.WriteTimeout)
+	require.Equal(t, cfg.IdleTimeout, server.IdleTimeout)
+	require.Equal(t, cfg.MaxHeaderBytes, server.MaxHeaderBytes)
+}
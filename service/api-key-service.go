@@ -0,0 +1,31 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+)
+
+// apiKeyPrefix makes keys recognizable, e.g. by secret scanners
+const apiKeyPrefix = "pk_"
+
+// ErrInvalidAPIKey is returned by Authenticate for an unknown or revoked key.
+var ErrInvalidAPIKey = apperr.Unauthorized("invalid API key")
+
+type APIKeyService interface {
+	Create(ctx context.Context, key model.APIKey) (model.APIKey, string, error)
+	GetAll(ctx context.Context) ([]model.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+	Authenticate(ctx context.Context, key string) (model.APIKey, error)
+}
+
+type APIKeyServiceImpl struct {
+	apiKeyRepository repository.APIKeyRepository
+}
+
+func NewAPIKeyService(apiKeyRepository repository.APIKeyRepository) APIKeyService {
+	return APIKeyServiceImpl{
+		apiKeyRepository: apiKeyRepository,
+	}
+}
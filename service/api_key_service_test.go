@@ -0,0 +1,149 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyService_Create(t *testing.T) {
+	type mockCreateRepo struct {
+		output model.APIKey
+		err    error
+	}
+
+	tcs := map[string]struct {
+		mockCreateRepo mockCreateRepo
+		expRes         model.APIKey
+		expErr         error
+	}{
+		"success": {
+			mockCreateRepo: mockCreateRepo{
+				output: model.APIKey{
+					ID:   1,
+					Name: "batch",
+				},
+			},
+			expRes: model.APIKey{
+				ID:   1,
+				Name: "batch",
+			},
+		},
+		"error": {
+			mockCreateRepo: mockCreateRepo{
+				err: errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockAPIKeyRepo := repository.NewMockAPIKeyRepository(t)
+
+			// When
+			var storedHash string
+			hashed := mock.MatchedBy(func(key model.APIKey) bool {
+				storedHash = key.KeyHash
+				return key.Name == "batch" && key.CreatedBy == 1 && key.KeyHash != ""
+			})
+			mockAPIKeyRepo.ExpectedCalls = []*mock.Call{
+				mockAPIKeyRepo.On("Create", ctx, hashed).Return(tc.mockCreateRepo.output, tc.mockCreateRepo.err),
+			}
+
+			serv := NewAPIKeyService(mockAPIKeyRepo)
+			rs, secret, err := serv.Create(ctx, model.APIKey{
+				Name:      "batch",
+				Scopes:    []model.Scope{model.ScopeProductsRead},
+				CreatedBy: 1,
+			})
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+				require.True(t, strings.HasPrefix(secret, apiKeyPrefix))
+				// only the hash of the returned key is stored
+				require.Equal(t, hashToken(secret), storedHash)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_Authenticate(t *testing.T) {
+	type mockGetByHashRepo struct {
+		output model.APIKey
+		err    error
+	}
+
+	tcs := map[string]struct {
+		mockGetByHashRepo mockGetByHashRepo
+		expRes            model.APIKey
+		expErr            error
+	}{
+		"success": {
+			mockGetByHashRepo: mockGetByHashRepo{
+				output: model.APIKey{ID: 1},
+			},
+			expRes: model.APIKey{ID: 1},
+		},
+		"error: unknown key": {
+			mockGetByHashRepo: mockGetByHashRepo{
+				err: apperr.NotFound("API key", sql.ErrNoRows),
+			},
+			expErr: ErrInvalidAPIKey,
+		},
+		"error: revoked key": {
+			mockGetByHashRepo: mockGetByHashRepo{
+				output: model.APIKey{
+					ID:        1,
+					RevokedAt: time.Now(),
+				},
+			},
+			expErr: ErrInvalidAPIKey,
+		},
+		"error: db failed": {
+			mockGetByHashRepo: mockGetByHashRepo{
+				err: errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockAPIKeyRepo := repository.NewMockAPIKeyRepository(t)
+
+			// When
+			mockAPIKeyRepo.ExpectedCalls = []*mock.Call{
+				mockAPIKeyRepo.On("GetByHash", ctx, hashToken("pk_secret")).Return(tc.mockGetByHashRepo.output, tc.mockGetByHashRepo.err),
+			}
+
+			serv := NewAPIKeyService(mockAPIKeyRepo)
+			rs, err := serv.Authenticate(ctx, "pk_secret")
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
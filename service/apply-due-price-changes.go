@@ -0,0 +1,43 @@
+package service
+
+import (
+	"chi-demo/tracing"
+	"context"
+	"time"
+)
+
+// priceChangeBatchSize is how many due price changes are fetched at once
+const priceChangeBatchSize = 100
+
+// ApplyDuePriceChanges applies the scheduled price changes effective by the
+// given time, oldest first so the latest one wins, and returns how many were
+// applied. Changes applied concurrently by another instance are skipped.
+func (priceServiceImpl PriceServiceImpl) ApplyDuePriceChanges(ctx context.Context, at time.Time) (_ int, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.ApplyDuePriceChanges")
+	defer tracing.End(span, &err)
+
+	var total int
+	for {
+		due, err := priceServiceImpl.priceChangeRepository.GetDue(ctx, at, priceChangeBatchSize)
+		if err != nil {
+			return total, err
+		}
+
+		var applied int
+		for _, change := range due {
+			ok, err := priceServiceImpl.priceChangeRepository.Apply(ctx, change.ID, at)
+			if err != nil {
+				return total, err
+			}
+			if ok {
+				applied++
+			}
+		}
+		total += applied
+
+		// a batch with nothing applied would come back as is
+		if len(due) < priceChangeBatchSize || applied == 0 {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"context"
+	"errors"
+)
+
+func (apiKeyServiceImpl APIKeyServiceImpl) Authenticate(ctx context.Context, key string) (model.APIKey, error) {
+	apiKey, err := apiKeyServiceImpl.apiKeyRepository.GetByHash(ctx, hashToken(key))
+	var notFound *apperr.NotFoundError
+	if errors.As(err, &notFound) {
+		return model.APIKey{}, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return model.APIKey{}, err
+	}
+
+	if !apiKey.RevokedAt.IsZero() {
+		return model.APIKey{}, ErrInvalidAPIKey
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"chi-demo/tracing"
+	"context"
+)
+
+func (priceServiceImpl PriceServiceImpl) CancelPriceChange(ctx context.Context, productID, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.CancelPriceChange")
+	defer tracing.End(span, &err)
+
+	return priceServiceImpl.priceChangeRepository.Cancel(ctx, productID, id)
+}
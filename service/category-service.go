@@ -0,0 +1,30 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+)
+
+type CategoryService interface {
+	GetAll(ctx context.Context) ([]model.Category, error)
+	GetOne(ctx context.Context, id int64) (model.Category, error)
+	Create(ctx context.Context, category model.Category) (model.Category, error)
+	Update(ctx context.Context, category model.Category) (model.Category, error)
+	Delete(ctx context.Context, id int64) error
+	GetProducts(ctx context.Context, id int64, query model.ProductListQuery) (model.ProductPage, error)
+	GetProductCategories(ctx context.Context, productID int64) ([]model.Category, error)
+	SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64) ([]model.Category, error)
+}
+
+type CategoryServiceImpl struct {
+	categoryRepository repository.CategoryRepository
+	productRepository  repository.ProductRepository
+}
+
+func NewCategoryService(categoryRepository repository.CategoryRepository, productRepository repository.ProductRepository) CategoryService {
+	return CategoryServiceImpl{
+		categoryRepository: categoryRepository,
+		productRepository:  productRepository,
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryService_GetProducts(t *testing.T) {
+	type mockGetAllRepo struct {
+		expCall bool
+		output  model.ProductPage
+		err     error
+	}
+
+	page := model.ProductPage{
+		Products: []model.Product{{ID: 1, Name: "running shoes"}},
+		Total:    1,
+	}
+
+	tcs := map[string]struct {
+		mockGetOneErr  error
+		mockGetAllRepo mockGetAllRepo
+		expRes         model.ProductPage
+		expErr         error
+	}{
+		"success": {
+			mockGetAllRepo: mockGetAllRepo{
+				expCall: true,
+				output:  page,
+			},
+			expRes: page,
+		},
+		"error: category not found": {
+			mockGetOneErr: apperr.NotFound("category", sql.ErrNoRows),
+			expErr:        apperr.NotFound("category", sql.ErrNoRows),
+		},
+		"error": {
+			mockGetAllRepo: mockGetAllRepo{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockCategoryRepo := repository.NewMockCategoryRepository(t)
+			mockProductRepo := repository.NewMockProductRepository(t)
+
+			// When
+			mockCategoryRepo.ExpectedCalls = []*mock.Call{
+				mockCategoryRepo.On("GetOne", mock.Anything, int64(1)).Return(model.Category{ID: 1}, tc.mockGetOneErr),
+			}
+			if tc.mockGetAllRepo.expCall {
+				// the category is added to the listing query
+				mockProductRepo.ExpectedCalls = []*mock.Call{
+					mockProductRepo.On("GetAll", mock.Anything, model.ProductListQuery{Limit: 20, CategoryID: 1}).Return(tc.mockGetAllRepo.output, tc.mockGetAllRepo.err),
+				}
+			}
+			serv := NewCategoryService(mockCategoryRepo, mockProductRepo)
+			rs, err := serv.GetProducts(ctx, 1, model.ProductListQuery{Limit: 20})
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
+
+func TestCategoryService_SetProductCategories(t *testing.T) {
+	type mockSetRepo struct {
+		expCall bool
+		err     error
+	}
+
+	categories := []model.Category{{ID: 4, ParentID: 1, Name: "shirts"}, {ID: 5, Name: "garden"}}
+
+	tcs := map[string]struct {
+		mockGetOneErr error
+		mockSetRepo   mockSetRepo
+		expRes        []model.Category
+		expErr        error
+	}{
+		"success": {
+			mockSetRepo: mockSetRepo{
+				expCall: true,
+			},
+			expRes: categories,
+		},
+		"error: product not found": {
+			mockGetOneErr: apperr.NotFound("product", sql.ErrNoRows),
+			expErr:        apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: unknown category": {
+			mockSetRepo: mockSetRepo{
+				expCall: true,
+				err:     apperr.Validation(apperr.FieldViolation{Field: "category_ids", Message: "contains an unknown category"}),
+			},
+			expErr: apperr.Validation(apperr.FieldViolation{Field: "category_ids", Message: "contains an unknown category"}),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockCategoryRepo := repository.NewMockCategoryRepository(t)
+			mockProductRepo := repository.NewMockProductRepository(t)
+
+			// When
+			mockProductRepo.ExpectedCalls = []*mock.Call{
+				mockProductRepo.On("GetOne", mock.Anything, int64(1), model.ReadOptions{}).Return(model.Product{ID: 1}, tc.mockGetOneErr),
+			}
+			if tc.mockSetRepo.expCall {
+				mockCategoryRepo.On("SetProductCategories", mock.Anything, int64(1), []int64{4, 5}).Return(tc.mockSetRepo.err)
+				if tc.mockSetRepo.err == nil {
+					mockCategoryRepo.On("GetByProduct", mock.Anything, int64(1)).Return(categories, nil)
+				}
+			}
+			serv := NewCategoryService(mockCategoryRepo, mockProductRepo)
+			rs, err := serv.SetProductCategories(ctx, 1, []int64{4, 5})
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"chi-demo/model"
+	"context"
+)
+
+// Create generates a new API key. The key is only returned here, just its
+// hash is stored.
+func (apiKeyServiceImpl APIKeyServiceImpl) Create(ctx context.Context, key model.APIKey) (model.APIKey, string, error) {
+	secret, err := randomToken()
+	if err != nil {
+		return model.APIKey{}, "", err
+	}
+	secret = apiKeyPrefix + secret
+
+	created, err := apiKeyServiceImpl.apiKeyRepository.Create(ctx, model.APIKey{
+		Name:      key.Name,
+		KeyHash:   hashToken(secret),
+		Scopes:    key.Scopes,
+		CreatedBy: key.CreatedBy,
+	})
+	if err != nil {
+		return model.APIKey{}, "", err
+	}
+
+	return created, secret, nil
+}
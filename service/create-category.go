@@ -0,0 +1,14 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+func (categoryServiceImpl CategoryServiceImpl) Create(ctx context.Context, category model.Category) (_ model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.Create")
+	defer tracing.End(span, &err)
+
+	return categoryServiceImpl.categoryRepository.Create(ctx, category)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// Create adds a price to the price list of a live product, the foreign key
+// alone would accept a tombstoned one.
+func (priceServiceImpl PriceServiceImpl) Create(ctx context.Context, price model.ProductPrice) (_ model.ProductPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.Create")
+	defer tracing.End(span, &err)
+
+	if _, err := priceServiceImpl.productRepository.GetOne(ctx, price.ProductID, model.ReadOptions{}); err != nil {
+		return model.ProductPrice{}, err
+	}
+
+	return priceServiceImpl.priceRepository.Create(ctx, price)
+}
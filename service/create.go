@@ -2,9 +2,13 @@ package service
 
 import (
 	"chi-demo/model"
+	"chi-demo/tracing"
 	"context"
 )
 
-func (productServiceImpl ProductServiceImpl) Create(ctx context.Context, product model.Product) error {
+func (productServiceImpl ProductServiceImpl) Create(ctx context.Context, product model.Product) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.Create")
+	defer tracing.End(span, &err)
+
 	return productServiceImpl.productRepository.Create(ctx, product)
 }
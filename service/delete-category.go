@@ -0,0 +1,14 @@
+package service
+
+import (
+	"chi-demo/tracing"
+	"context"
+)
+
+// Delete removes an empty category, its products are only unassigned.
+func (categoryServiceImpl CategoryServiceImpl) Delete(ctx context.Context, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.Delete")
+	defer tracing.End(span, &err)
+
+	return categoryServiceImpl.categoryRepository.Delete(ctx, id)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// Delete removes a price from the price list of a live product, a
+// tombstoned product is not found like for Create.
+func (priceServiceImpl PriceServiceImpl) Delete(ctx context.Context, productID, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.Delete")
+	defer tracing.End(span, &err)
+
+	if _, err := priceServiceImpl.productRepository.GetOne(ctx, productID, model.ReadOptions{}); err != nil {
+		return err
+	}
+
+	return priceServiceImpl.priceRepository.Delete(ctx, productID, id)
+}
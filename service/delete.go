@@ -1,7 +1,13 @@
 package service
 
-import "context"
+import (
+	"chi-demo/tracing"
+	"context"
+)
+
+func (productServiceImpl ProductServiceImpl) Delete(ctx context.Context, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.Delete")
+	defer tracing.End(span, &err)
 
-func (productServiceImpl ProductServiceImpl) Delete(ctx context.Context, id int64) error {
 	return productServiceImpl.productRepository.Delete(ctx, id)
 }
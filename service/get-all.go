@@ -2,9 +2,13 @@ package service
 
 import (
 	"chi-demo/model"
+	"chi-demo/tracing"
 	"context"
 )
 
-func (productServiceImpl ProductServiceImpl) GetAll(ctx context.Context) ([]model.Product, error) {
-	return productServiceImpl.productRepository.GetAll(ctx)
+func (productServiceImpl ProductServiceImpl) GetAll(ctx context.Context, query model.ProductListQuery) (_ model.ProductPage, err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.GetAll")
+	defer tracing.End(span, &err)
+
+	return productServiceImpl.productRepository.GetAll(ctx, query)
 }
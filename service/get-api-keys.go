@@ -0,0 +1,10 @@
+package service
+
+import (
+	"chi-demo/model"
+	"context"
+)
+
+func (apiKeyServiceImpl APIKeyServiceImpl) GetAll(ctx context.Context) ([]model.APIKey, error) {
+	return apiKeyServiceImpl.apiKeyRepository.GetAll(ctx)
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+func (categoryServiceImpl CategoryServiceImpl) GetAll(ctx context.Context) (_ []model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.GetAll")
+	defer tracing.End(span, &err)
+
+	return categoryServiceImpl.categoryRepository.GetAll(ctx)
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetProducts lists the products of a category and of all of its
+// descendants, with the filters and paging of the product listing.
+func (categoryServiceImpl CategoryServiceImpl) GetProducts(ctx context.Context, id int64, query model.ProductListQuery) (_ model.ProductPage, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.GetProducts")
+	defer tracing.End(span, &err)
+
+	if _, err := categoryServiceImpl.categoryRepository.GetOne(ctx, id); err != nil {
+		return model.ProductPage{}, err
+	}
+
+	query.CategoryID = id
+	return categoryServiceImpl.productRepository.GetAll(ctx, query)
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+func (categoryServiceImpl CategoryServiceImpl) GetOne(ctx context.Context, id int64) (_ model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.GetOne")
+	defer tracing.End(span, &err)
+
+	return categoryServiceImpl.categoryRepository.GetOne(ctx, id)
+}
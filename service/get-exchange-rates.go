@@ -0,0 +1,14 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+func (priceServiceImpl PriceServiceImpl) GetExchangeRates(ctx context.Context) (_ model.ExchangeRates, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.GetExchangeRates")
+	defer tracing.End(span, &err)
+
+	return priceServiceImpl.exchangeRateRepository.Get(ctx)
+}
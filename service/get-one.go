@@ -2,9 +2,13 @@ package service
 
 import (
 	"chi-demo/model"
+	"chi-demo/tracing"
 	"context"
 )
 
-func (productServiceImpl ProductServiceImpl) GetOne(ctx context.Context, id int64) (model.Product, error) {
-	return productServiceImpl.productRepository.GetOne(ctx, id)
+func (productServiceImpl ProductServiceImpl) GetOne(ctx context.Context, id int64, opts model.ReadOptions) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.GetOne")
+	defer tracing.End(span, &err)
+
+	return productServiceImpl.productRepository.GetOne(ctx, id, opts)
 }
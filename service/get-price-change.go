@@ -0,0 +1,20 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetPriceChange returns a price change of a product, tombstoned products
+// included like in the history.
+func (priceServiceImpl PriceServiceImpl) GetPriceChange(ctx context.Context, productID, id int64) (_ model.PriceChange, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.GetPriceChange")
+	defer tracing.End(span, &err)
+
+	if _, err := priceServiceImpl.productRepository.GetOne(ctx, productID, model.ReadOptions{IncludeDeleted: true}); err != nil {
+		return model.PriceChange{}, err
+	}
+
+	return priceServiceImpl.priceChangeRepository.GetOne(ctx, productID, id)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetPriceHistory returns the price changes of a product, tombstoned ones
+// included since the history is an audit trail.
+func (priceServiceImpl PriceServiceImpl) GetPriceHistory(ctx context.Context, productID int64) (_ []model.PriceChange, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.GetPriceHistory")
+	defer tracing.End(span, &err)
+
+	if _, err := priceServiceImpl.productRepository.GetOne(ctx, productID, model.ReadOptions{IncludeDeleted: true}); err != nil {
+		return nil, err
+	}
+
+	return priceServiceImpl.priceChangeRepository.GetHistory(ctx, productID)
+}
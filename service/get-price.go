@@ -0,0 +1,19 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetOne returns a price of the price list of a live product.
+func (priceServiceImpl PriceServiceImpl) GetOne(ctx context.Context, productID, id int64) (_ model.ProductPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.GetOne")
+	defer tracing.End(span, &err)
+
+	if _, err := priceServiceImpl.productRepository.GetOne(ctx, productID, model.ReadOptions{}); err != nil {
+		return model.ProductPrice{}, err
+	}
+
+	return priceServiceImpl.priceRepository.GetOne(ctx, productID, id)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetAll returns the price list of a live product.
+func (priceServiceImpl PriceServiceImpl) GetAll(ctx context.Context, productID int64) (_ []model.ProductPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.GetAll")
+	defer tracing.End(span, &err)
+
+	if _, err := priceServiceImpl.productRepository.GetOne(ctx, productID, model.ReadOptions{}); err != nil {
+		return nil, err
+	}
+
+	return priceServiceImpl.priceRepository.GetAll(ctx, productID)
+}
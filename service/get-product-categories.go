@@ -0,0 +1,20 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// GetProductCategories returns the categories a live product is directly
+// assigned to.
+func (categoryServiceImpl CategoryServiceImpl) GetProductCategories(ctx context.Context, productID int64) (_ []model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.GetProductCategories")
+	defer tracing.End(span, &err)
+
+	if _, err := categoryServiceImpl.productRepository.GetOne(ctx, productID, model.ReadOptions{}); err != nil {
+		return nil, err
+	}
+
+	return categoryServiceImpl.categoryRepository.GetByProduct(ctx, productID)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// readinessCheckTimeout keeps a hanging database from hanging the probe
+const readinessCheckTimeout = 2 * time.Second
+
+type HealthService interface {
+	Readiness(ctx context.Context) model.HealthReport
+	// Drain makes the service report not-ready, it is called once the
+	// server starts shutting down
+	Drain()
+}
+
+type HealthServiceImpl struct {
+	healthRepository repository.HealthRepository
+	// schemaVersion is the latest migration this build knows about
+	schemaVersion int64
+	draining      *atomic.Bool
+}
+
+func NewHealthService(healthRepository repository.HealthRepository, schemaVersion int64) HealthService {
+	return HealthServiceImpl{
+		healthRepository: healthRepository,
+		schemaVersion:    schemaVersion,
+		draining:         &atomic.Bool{},
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"bytes"
+	"chi-demo/config"
+	"chi-demo/log"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthService_Readiness(t *testing.T) {
+	type mockVersionRepo struct {
+		output model.SchemaVersion
+		err    error
+	}
+
+	tcs := map[string]struct {
+		givenDraining   bool
+		mockPingErr     error
+		mockVersionRepo mockVersionRepo
+		expStatus       model.HealthStatus
+		expErrors       map[string]string
+		expLog          string
+	}{
+		"success": {
+			mockVersionRepo: mockVersionRepo{
+				output: model.SchemaVersion{Version: 6},
+			},
+			expStatus: model.HealthStatusOK,
+			expErrors: map[string]string{},
+		},
+		"success - newer schema": {
+			mockVersionRepo: mockVersionRepo{
+				output: model.SchemaVersion{Version: 7},
+			},
+			expStatus: model.HealthStatusOK,
+			expErrors: map[string]string{},
+		},
+		"fail - draining": {
+			givenDraining: true,
+			mockVersionRepo: mockVersionRepo{
+				output: model.SchemaVersion{Version: 6},
+			},
+			expStatus: model.HealthStatusFail,
+			expErrors: map[string]string{
+				"shutdown": "shutting down",
+			},
+		},
+		"fail - database down": {
+			mockPingErr: errors.New("connection refused"),
+			mockVersionRepo: mockVersionRepo{
+				err: errors.New("connection refused"),
+			},
+			expStatus: model.HealthStatusFail,
+			expErrors: map[string]string{
+				"database":   "database unavailable",
+				"migrations": "schema version mismatch",
+			},
+			expLog: "connection refused",
+		},
+		"fail - pending migrations": {
+			mockVersionRepo: mockVersionRepo{
+				output: model.SchemaVersion{Version: 5},
+			},
+			expStatus: model.HealthStatusFail,
+			expErrors: map[string]string{
+				"migrations": "schema version mismatch",
+			},
+			expLog: "schema at version 5, expected 6",
+		},
+		"fail - dirty migration": {
+			mockVersionRepo: mockVersionRepo{
+				output: model.SchemaVersion{Version: 6, Dirty: true},
+			},
+			expStatus: model.HealthStatusFail,
+			expErrors: map[string]string{
+				"migrations": "schema version mismatch",
+			},
+			expLog: "migration 6 is dirty",
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			var out bytes.Buffer
+			logger, err := log.New(config.LogConfig{Level: "info", Format: "json"}, &out)
+			require.NoError(t, err)
+			ctx := log.NewContext(context.Background(), logger)
+			mockHealthRepo := repository.NewMockHealthRepository(t)
+
+			// When
+			mockHealthRepo.ExpectedCalls = []*mock.Call{
+				mockHealthRepo.On("Ping", mock.Anything).Return(tc.mockPingErr),
+				mockHealthRepo.On("GetSchemaVersion", mock.Anything).Return(tc.mockVersionRepo.output, tc.mockVersionRepo.err),
+			}
+
+			serv := NewHealthService(mockHealthRepo, 6)
+			if tc.givenDraining {
+				serv.Drain()
+			}
+			rs := serv.Readiness(ctx)
+
+			// Then
+			require.Equal(t, tc.expStatus, rs.Status)
+			require.Len(t, rs.Checks, 3)
+			errs := map[string]string{}
+			for _, check := range rs.Checks {
+				require.GreaterOrEqual(t, check.LatencyMs, float64(0))
+				if check.Error != "" {
+					require.Equal(t, model.HealthStatusFail, check.Status)
+					errs[check.Name] = check.Error
+				} else {
+					require.Equal(t, model.HealthStatusOK, check.Status)
+				}
+			}
+			require.Equal(t, tc.expErrors, errs)
+			// the detailed error is only logged
+			require.Contains(t, out.String(), tc.expLog)
+		})
+	}
+}
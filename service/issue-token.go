@@ -0,0 +1,74 @@
+package service
+
+import (
+	"chi-demo/model"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/jwtauth/v5"
+)
+
+// issueTokens signs a new access token and stores a new refresh token for
+// the user. A zero familyID starts a new refresh token family.
+func (userServiceImpl UserServiceImpl) issueTokens(ctx context.Context, user model.User, familyID int64) (model.AuthToken, error) {
+	jti, err := randomToken()
+	if err != nil {
+		return model.AuthToken{}, err
+	}
+
+	// user_id is a string since sonyflake ids don't survive the float64
+	// round trip of JSON numbers
+	claims := map[string]interface{}{
+		"user_id": strconv.FormatInt(user.ID, 10),
+		"role":    string(user.Role),
+		"jti":     jti,
+	}
+	jwtauth.SetIssuedNow(claims)
+	jwtauth.SetExpiryIn(claims, AccessTokenTTL)
+
+	_, accessToken, err := userServiceImpl.tokenAuth.Encode(claims)
+	if err != nil {
+		return model.AuthToken{}, err
+	}
+
+	refreshToken, err := randomToken()
+	if err != nil {
+		return model.AuthToken{}, err
+	}
+
+	if _, err := userServiceImpl.tokenRepository.CreateRefreshToken(ctx, model.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: time.Now().Add(RefreshTokenTTL),
+	}); err != nil {
+		return model.AuthToken{}, err
+	}
+
+	return model.AuthToken{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(AccessTokenTTL.Seconds()),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken is what gets stored for a refresh token. The tokens are random
+// so a plain digest is enough, unlike passwords.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
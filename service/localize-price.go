@@ -0,0 +1,52 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Localize returns the price of product in currency at the given time. An
+// explicit price of the price list wins, then the product price when it is
+// already in currency, else the product price is converted at the current
+// exchange rate.
+func (priceServiceImpl PriceServiceImpl) Localize(ctx context.Context, product model.Product, currency string, at time.Time) (_ model.LocalPrice, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.Localize")
+	defer tracing.End(span, &err)
+
+	price, err := priceServiceImpl.priceRepository.GetEffective(ctx, product.ID, currency, at)
+	var notFound *apperr.NotFoundError
+	switch {
+	case err == nil:
+		return model.LocalPrice{Price: price.Price, Source: model.PriceSourcePriceList}, nil
+	case !errors.As(err, &notFound):
+		return model.LocalPrice{}, err
+	}
+
+	if product.Price.Currency == currency {
+		return model.LocalPrice{Price: product.Price, Source: model.PriceSourceBase}, nil
+	}
+
+	rates, err := priceServiceImpl.exchangeRateRepository.Get(ctx)
+	if err != nil {
+		return model.LocalPrice{}, err
+	}
+	rate, ok := rates.Rate(product.Price.Currency, currency)
+	if !ok {
+		// the product exists, so this must not read as a 404
+		return model.LocalPrice{}, apperr.Validation(apperr.FieldViolation{
+			Field:   "currency",
+			Message: fmt.Sprintf("has no exchange rate from %s", product.Price.Currency),
+		})
+	}
+
+	converted, err := product.Price.Convert(currency, rate)
+	if err != nil {
+		return model.LocalPrice{}, err
+	}
+	return model.LocalPrice{Price: converted, Source: model.PriceSourceExchangeRate}, nil
+}
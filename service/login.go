@@ -0,0 +1,34 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"context"
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyPasswordHash is compared against when the username is unknown, so
+// both failures cost a bcrypt compare at the cost Register uses.
+const dummyPasswordHash = "$2a$10$0ZoFsKB15OKpbpy.Ud.c3OLfFf2sS7Xu6lK0M7IcobZzRikFzYKG."
+
+// Login checks the credentials and issues a signed access token along with a
+// refresh token starting a new family.
+func (userServiceImpl UserServiceImpl) Login(ctx context.Context, username, password string) (model.AuthToken, error) {
+	user, err := userServiceImpl.userRepository.GetByUsername(ctx, username)
+	var notFound *apperr.NotFoundError
+	if errors.As(err, &notFound) {
+		bcrypt.CompareHashAndPassword([]byte(dummyPasswordHash), []byte(password))
+		return model.AuthToken{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return model.AuthToken{}, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return model.AuthToken{}, ErrInvalidCredentials
+	}
+
+	return userServiceImpl.issueTokens(ctx, user, 0)
+}
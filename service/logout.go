@@ -0,0 +1,35 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"context"
+	"errors"
+	"time"
+)
+
+// Logout denylists the access token until it expires. When a refresh token
+// of the same user is given, its whole family is revoked too.
+func (userServiceImpl UserServiceImpl) Logout(ctx context.Context, userID int64, jti string, expiresAt time.Time, refreshToken string) error {
+	if err := userServiceImpl.tokenRepository.DenyAccessToken(ctx, jti, expiresAt); err != nil {
+		return err
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	token, err := userServiceImpl.tokenRepository.GetRefreshTokenByHash(ctx, hashToken(refreshToken))
+	var notFound *apperr.NotFoundError
+	if errors.As(err, &notFound) || (err == nil && token.UserID != userID) {
+		return ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return err
+	}
+
+	return userServiceImpl.tokenRepository.RevokeRefreshTokenFamily(ctx, token.FamilyID)
+}
+
+func (userServiceImpl UserServiceImpl) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return userServiceImpl.tokenRepository.IsAccessTokenDenied(ctx, jti)
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"chi-demo/metrics"
+	"chi-demo/model"
+	"context"
+)
+
+// productServiceMetrics counts the business events, the other methods go
+// straight to the embedded service.
+type productServiceMetrics struct {
+	ProductService
+	metrics *metrics.Metrics
+}
+
+func NewProductServiceMetrics(next ProductService, m *metrics.Metrics) ProductService {
+	return productServiceMetrics{
+		ProductService: next,
+		metrics:        m,
+	}
+}
+
+func (s productServiceMetrics) Create(ctx context.Context, product model.Product) (model.Product, error) {
+	product, err := s.ProductService.Create(ctx, product)
+	if err != nil {
+		return model.Product{}, err
+	}
+	s.metrics.ProductCreated()
+	return product, nil
+}
+
+func (s productServiceMetrics) Delete(ctx context.Context, id int64) error {
+	if err := s.ProductService.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.metrics.ProductDeleted()
+	return nil
+}
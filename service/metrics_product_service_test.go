@@ -0,0 +1,103 @@
+package service
+
+import (
+	"chi-demo/metrics"
+	"chi-demo/model"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductServiceMetrics(t *testing.T) {
+	tcs := map[string]struct {
+		givenCall  func(ctx context.Context, serv ProductService) error
+		mockMethod string
+		mockArgs   []interface{}
+		mockReturn []interface{}
+		expMetrics []string
+		expErr     error
+	}{
+		"create": {
+			givenCall: func(ctx context.Context, serv ProductService) error {
+				_, err := serv.Create(ctx, model.Product{Name: "shoes"})
+				return err
+			},
+			mockMethod: "Create",
+			mockArgs:   []interface{}{model.Product{Name: "shoes"}},
+			mockReturn: []interface{}{model.Product{ID: 1, Name: "shoes"}, nil},
+			expMetrics: []string{"products_created_total 1", "products_deleted_total 0"},
+		},
+		"create error": {
+			givenCall: func(ctx context.Context, serv ProductService) error {
+				_, err := serv.Create(ctx, model.Product{Name: "shoes"})
+				return err
+			},
+			mockMethod: "Create",
+			mockArgs:   []interface{}{model.Product{Name: "shoes"}},
+			mockReturn: []interface{}{model.Product{}, errors.New("test")},
+			expMetrics: []string{"products_created_total 0"},
+			expErr:     errors.New("test"),
+		},
+		"delete": {
+			givenCall: func(ctx context.Context, serv ProductService) error {
+				return serv.Delete(ctx, 1)
+			},
+			mockMethod: "Delete",
+			mockArgs:   []interface{}{int64(1)},
+			mockReturn: []interface{}{nil},
+			expMetrics: []string{"products_created_total 0", "products_deleted_total 1"},
+		},
+		"delete error": {
+			givenCall: func(ctx context.Context, serv ProductService) error {
+				return serv.Delete(ctx, 1)
+			},
+			mockMethod: "Delete",
+			mockArgs:   []interface{}{int64(1)},
+			mockReturn: []interface{}{errors.New("test")},
+			expMetrics: []string{"products_deleted_total 0"},
+			expErr:     errors.New("test"),
+		},
+		"other methods are not counted": {
+			givenCall: func(ctx context.Context, serv ProductService) error {
+				_, err := serv.Restore(ctx, 1)
+				return err
+			},
+			mockMethod: "Restore",
+			mockArgs:   []interface{}{int64(1)},
+			mockReturn: []interface{}{model.Product{ID: 1}, nil},
+			expMetrics: []string{"products_created_total 0", "products_deleted_total 0"},
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			m := metrics.New()
+			mockProductService := NewMockProductService(t)
+
+			// When
+			mockProductService.ExpectedCalls = []*mock.Call{
+				mockProductService.On(tc.mockMethod, append([]interface{}{ctx}, tc.mockArgs...)...).Return(tc.mockReturn...),
+			}
+			err := tc.givenCall(ctx, NewProductServiceMetrics(mockProductService, m))
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			res := httptest.NewRecorder()
+			m.Handler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			for _, expMetric := range tc.expMetrics {
+				require.Contains(t, res.Body.String(), expMetric)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package service
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCategoryService is an autogenerated mock type for the CategoryService type
+type MockCategoryService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, category
+func (_m *MockCategoryService) Create(ctx context.Context, category model.Category) (model.Category, error) {
+	ret := _m.Called(ctx, category)
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) (model.Category, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) model.Category); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Category) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockCategoryService) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *MockCategoryService) GetAll(ctx context.Context) ([]model.Category, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Category, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *MockCategoryService) GetOne(ctx context.Context, id int64) (model.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductCategories provides a mock function with given fields: ctx, productID
+func (_m *MockCategoryService) GetProductCategories(ctx context.Context, productID int64) ([]model.Category, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Category, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Category); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProducts provides a mock function with given fields: ctx, id, query
+func (_m *MockCategoryService) GetProducts(ctx context.Context, id int64, query model.ProductListQuery) (model.ProductPage, error) {
+	ret := _m.Called(ctx, id, query)
+
+	var r0 model.ProductPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.ProductListQuery) (model.ProductPage, error)); ok {
+		return rf(ctx, id, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.ProductListQuery) model.ProductPage); ok {
+		r0 = rf(ctx, id, query)
+	} else {
+		r0 = ret.Get(0).(model.ProductPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.ProductListQuery) error); ok {
+		r1 = rf(ctx, id, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetProductCategories provides a mock function with given fields: ctx, productID, categoryIDs
+func (_m *MockCategoryService) SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64) ([]model.Category, error) {
+	ret := _m.Called(ctx, productID, categoryIDs)
+
+	var r0 []model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]model.Category, error)); ok {
+		return rf(ctx, productID, categoryIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []model.Category); ok {
+		r0 = rf(ctx, productID, categoryIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, productID, categoryIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, category
+func (_m *MockCategoryService) Update(ctx context.Context, category model.Category) (model.Category, error) {
+	ret := _m.Called(ctx, category)
+
+	var r0 model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) (model.Category, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) model.Category); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Get(0).(model.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Category) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockCategoryService creates a new instance of MockCategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCategoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCategoryService {
+	mock := &MockCategoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
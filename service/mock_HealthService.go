@@ -0,0 +1,48 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package service
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHealthService is an autogenerated mock type for the HealthService type
+type MockHealthService struct {
+	mock.Mock
+}
+
+// Drain provides a mock function with given fields:
+func (_m *MockHealthService) Drain() {
+	_m.Called()
+}
+
+// Readiness provides a mock function with given fields: ctx
+func (_m *MockHealthService) Readiness(ctx context.Context) model.HealthReport {
+	ret := _m.Called(ctx)
+
+	var r0 model.HealthReport
+	if rf, ok := ret.Get(0).(func(context.Context) model.HealthReport); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(model.HealthReport)
+	}
+
+	return r0
+}
+
+// NewMockHealthService creates a new instance of MockHealthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHealthService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHealthService {
+	mock := &MockHealthService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
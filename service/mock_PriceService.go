@@ -0,0 +1,303 @@
+// Code generated by mockery v2.34.2. DO NOT EDIT.
+
+package service
+
+import (
+	model "chi-demo/model"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockPriceService is an autogenerated mock type for the PriceService type
+type MockPriceService struct {
+	mock.Mock
+}
+
+// ApplyDuePriceChanges provides a mock function with given fields: ctx, at
+func (_m *MockPriceService) ApplyDuePriceChanges(ctx context.Context, at time.Time) (int, error) {
+	ret := _m.Called(ctx, at)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, at)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelPriceChange provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceService) CancelPriceChange(ctx context.Context, productID int64, id int64) error {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, price
+func (_m *MockPriceService) Create(ctx context.Context, price model.ProductPrice) (model.ProductPrice, error) {
+	ret := _m.Called(ctx, price)
+
+	var r0 model.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductPrice) (model.ProductPrice, error)); ok {
+		return rf(ctx, price)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductPrice) model.ProductPrice); ok {
+		r0 = rf(ctx, price)
+	} else {
+		r0 = ret.Get(0).(model.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductPrice) error); ok {
+		r1 = rf(ctx, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceService) Delete(ctx context.Context, productID int64, id int64) error {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx, productID
+func (_m *MockPriceService) GetAll(ctx context.Context, productID int64) ([]model.ProductPrice, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []model.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.ProductPrice, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.ProductPrice); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExchangeRates provides a mock function with given fields: ctx
+func (_m *MockPriceService) GetExchangeRates(ctx context.Context) (model.ExchangeRates, error) {
+	ret := _m.Called(ctx)
+
+	var r0 model.ExchangeRates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (model.ExchangeRates, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) model.ExchangeRates); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(model.ExchangeRates)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceService) GetOne(ctx context.Context, productID int64, id int64) (model.ProductPrice, error) {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 model.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.ProductPrice, error)); ok {
+		return rf(ctx, productID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.ProductPrice); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Get(0).(model.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, productID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceChange provides a mock function with given fields: ctx, productID, id
+func (_m *MockPriceService) GetPriceChange(ctx context.Context, productID int64, id int64) (model.PriceChange, error) {
+	ret := _m.Called(ctx, productID, id)
+
+	var r0 model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.PriceChange, error)); ok {
+		return rf(ctx, productID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.PriceChange); ok {
+		r0 = rf(ctx, productID, id)
+	} else {
+		r0 = ret.Get(0).(model.PriceChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, productID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, productID
+func (_m *MockPriceService) GetPriceHistory(ctx context.Context, productID int64) ([]model.PriceChange, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.PriceChange, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.PriceChange); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Localize provides a mock function with given fields: ctx, product, currency, at
+func (_m *MockPriceService) Localize(ctx context.Context, product model.Product, currency string, at time.Time) (model.LocalPrice, error) {
+	ret := _m.Called(ctx, product, currency, at)
+
+	var r0 model.LocalPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product, string, time.Time) (model.LocalPrice, error)); ok {
+		return rf(ctx, product, currency, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product, string, time.Time) model.LocalPrice); ok {
+		r0 = rf(ctx, product, currency, at)
+	} else {
+		r0 = ret.Get(0).(model.LocalPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Product, string, time.Time) error); ok {
+		r1 = rf(ctx, product, currency, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchedulePriceChange provides a mock function with given fields: ctx, change
+func (_m *MockPriceService) SchedulePriceChange(ctx context.Context, change model.PriceChange) (model.PriceChange, error) {
+	ret := _m.Called(ctx, change)
+
+	var r0 model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PriceChange) (model.PriceChange, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PriceChange) model.PriceChange); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Get(0).(model.PriceChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PriceChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetExchangeRates provides a mock function with given fields: ctx, rates
+func (_m *MockPriceService) SetExchangeRates(ctx context.Context, rates model.ExchangeRates) (model.ExchangeRates, error) {
+	ret := _m.Called(ctx, rates)
+
+	var r0 model.ExchangeRates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExchangeRates) (model.ExchangeRates, error)); ok {
+		return rf(ctx, rates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExchangeRates) model.ExchangeRates); ok {
+		r0 = rf(ctx, rates)
+	} else {
+		r0 = ret.Get(0).(model.ExchangeRates)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ExchangeRates) error); ok {
+		r1 = rf(ctx, rates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockPriceService creates a new instance of MockPriceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPriceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPriceService {
+	mock := &MockPriceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"chi-demo/log"
+	"context"
+	"time"
+)
+
+// PriceScheduler applies the scheduled price changes once they come due.
+type PriceScheduler struct {
+	priceService PriceService
+	interval     time.Duration
+	logger       log.Logger
+}
+
+func NewPriceScheduler(priceService PriceService, interval time.Duration, logger log.Logger) PriceScheduler {
+	return PriceScheduler{
+		priceService: priceService,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+// Run applies the due price changes right away, then every interval until
+// ctx is done. Failures are logged and retried on the next tick.
+func (s PriceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s PriceScheduler) tick(ctx context.Context) {
+	applied, err := s.priceService.ApplyDuePriceChanges(ctx, time.Now())
+	if applied > 0 {
+		s.logger.Info("price changes applied", "count", applied)
+	}
+	if err != nil && ctx.Err() == nil {
+		s.logger.Error("applying price changes", "error", err)
+	}
+}
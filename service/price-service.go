@@ -0,0 +1,39 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"time"
+)
+
+type PriceService interface {
+	GetAll(ctx context.Context, productID int64) ([]model.ProductPrice, error)
+	GetOne(ctx context.Context, productID, id int64) (model.ProductPrice, error)
+	Create(ctx context.Context, price model.ProductPrice) (model.ProductPrice, error)
+	Delete(ctx context.Context, productID, id int64) error
+	Localize(ctx context.Context, product model.Product, currency string, at time.Time) (model.LocalPrice, error)
+	GetExchangeRates(ctx context.Context) (model.ExchangeRates, error)
+	SetExchangeRates(ctx context.Context, rates model.ExchangeRates) (model.ExchangeRates, error)
+	SchedulePriceChange(ctx context.Context, change model.PriceChange) (model.PriceChange, error)
+	GetPriceHistory(ctx context.Context, productID int64) ([]model.PriceChange, error)
+	GetPriceChange(ctx context.Context, productID, id int64) (model.PriceChange, error)
+	CancelPriceChange(ctx context.Context, productID, id int64) error
+	ApplyDuePriceChanges(ctx context.Context, at time.Time) (int, error)
+}
+
+type PriceServiceImpl struct {
+	productRepository      repository.ProductRepository
+	priceRepository        repository.PriceRepository
+	exchangeRateRepository repository.ExchangeRateRepository
+	priceChangeRepository  repository.PriceChangeRepository
+}
+
+func NewPriceService(productRepository repository.ProductRepository, priceRepository repository.PriceRepository, exchangeRateRepository repository.ExchangeRateRepository, priceChangeRepository repository.PriceChangeRepository) PriceService {
+	return PriceServiceImpl{
+		productRepository:      productRepository,
+		priceRepository:        priceRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		priceChangeRepository:  priceChangeRepository,
+	}
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceService_Localize(t *testing.T) {
+	type mockGetEffectiveRepo struct {
+		output model.ProductPrice
+		err    error
+	}
+	type mockGetRatesRepo struct {
+		expCall bool
+		output  model.ExchangeRates
+		err     error
+	}
+
+	at := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	rates := model.ExchangeRates{
+		Base:  "USD",
+		Rates: map[string]decimal.Decimal{"EUR": decimal.RequireFromString("0.92")},
+	}
+
+	tcs := map[string]struct {
+		givenProduct         model.Product
+		givenCurrency        string
+		mockGetEffectiveRepo mockGetEffectiveRepo
+		mockGetRatesRepo     mockGetRatesRepo
+		expRes               model.LocalPrice
+		expErr               error
+	}{
+		"success: price list": {
+			givenProduct:  model.Product{ID: 1, Price: model.Money{Amount: 1000, Currency: "USD"}},
+			givenCurrency: "EUR",
+			mockGetEffectiveRepo: mockGetEffectiveRepo{
+				output: model.ProductPrice{ID: 2, ProductID: 1, Price: model.Money{Amount: 899, Currency: "EUR"}},
+			},
+			expRes: model.LocalPrice{Price: model.Money{Amount: 899, Currency: "EUR"}, Source: model.PriceSourcePriceList},
+		},
+		"success: base": {
+			givenProduct:  model.Product{ID: 1, Price: model.Money{Amount: 1000, Currency: "USD"}},
+			givenCurrency: "USD",
+			mockGetEffectiveRepo: mockGetEffectiveRepo{
+				err: apperr.NotFound("price", sql.ErrNoRows),
+			},
+			expRes: model.LocalPrice{Price: model.Money{Amount: 1000, Currency: "USD"}, Source: model.PriceSourceBase},
+		},
+		"success: exchange rate": {
+			givenProduct:  model.Product{ID: 1, Price: model.Money{Amount: 1000, Currency: "USD"}},
+			givenCurrency: "EUR",
+			mockGetEffectiveRepo: mockGetEffectiveRepo{
+				err: apperr.NotFound("price", sql.ErrNoRows),
+			},
+			mockGetRatesRepo: mockGetRatesRepo{
+				expCall: true,
+				output:  rates,
+			},
+			expRes: model.LocalPrice{Price: model.Money{Amount: 920, Currency: "EUR"}, Source: model.PriceSourceExchangeRate},
+		},
+		"error: no exchange rate": {
+			givenProduct:  model.Product{ID: 1, Price: model.Money{Amount: 1000, Currency: "USD"}},
+			givenCurrency: "GBP",
+			mockGetEffectiveRepo: mockGetEffectiveRepo{
+				err: apperr.NotFound("price", sql.ErrNoRows),
+			},
+			mockGetRatesRepo: mockGetRatesRepo{
+				expCall: true,
+				output:  rates,
+			},
+			expErr: apperr.Validation(apperr.FieldViolation{Field: "currency", Message: "has no exchange rate from USD"}),
+		},
+		"error: price list": {
+			givenProduct:  model.Product{ID: 1, Price: model.Money{Amount: 1000, Currency: "USD"}},
+			givenCurrency: "EUR",
+			mockGetEffectiveRepo: mockGetEffectiveRepo{
+				err: errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+		"error: exchange rates": {
+			givenProduct:  model.Product{ID: 1, Price: model.Money{Amount: 1000, Currency: "USD"}},
+			givenCurrency: "EUR",
+			mockGetEffectiveRepo: mockGetEffectiveRepo{
+				err: apperr.NotFound("price", sql.ErrNoRows),
+			},
+			mockGetRatesRepo: mockGetRatesRepo{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockPriceRepo := repository.NewMockPriceRepository(t)
+			mockRateRepo := repository.NewMockExchangeRateRepository(t)
+
+			// When
+			mockPriceRepo.ExpectedCalls = []*mock.Call{
+				mockPriceRepo.On("GetEffective", mock.Anything, tc.givenProduct.ID, tc.givenCurrency, at).
+					Return(tc.mockGetEffectiveRepo.output, tc.mockGetEffectiveRepo.err),
+			}
+			if tc.mockGetRatesRepo.expCall {
+				mockRateRepo.ExpectedCalls = []*mock.Call{
+					mockRateRepo.On("Get", mock.Anything).Return(tc.mockGetRatesRepo.output, tc.mockGetRatesRepo.err),
+				}
+			}
+			serv := NewPriceService(repository.NewMockProductRepository(t), mockPriceRepo, mockRateRepo, repository.NewMockPriceChangeRepository(t))
+			rs, err := serv.Localize(ctx, tc.givenProduct, tc.givenCurrency, at)
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
+
+func TestPriceService_Create(t *testing.T) {
+	type mockCreateRepo struct {
+		expCall bool
+		output  model.ProductPrice
+		err     error
+	}
+
+	price := model.ProductPrice{
+		ProductID: 1,
+		Price:     model.Money{Amount: 899, Currency: "EUR"},
+		ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tcs := map[string]struct {
+		mockGetOneErr  error
+		mockCreateRepo mockCreateRepo
+		expRes         model.ProductPrice
+		expErr         error
+	}{
+		"success": {
+			mockCreateRepo: mockCreateRepo{
+				expCall: true,
+				output:  model.ProductPrice{ID: 2, ProductID: 1, Price: price.Price, ValidFrom: price.ValidFrom},
+			},
+			expRes: model.ProductPrice{ID: 2, ProductID: 1, Price: price.Price, ValidFrom: price.ValidFrom},
+		},
+		"error: product not found": {
+			mockGetOneErr: apperr.NotFound("product", sql.ErrNoRows),
+			expErr:        apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: overlapping": {
+			mockCreateRepo: mockCreateRepo{
+				expCall: true,
+				err:     apperr.Conflict("price overlaps another price in this currency", nil),
+			},
+			expErr: apperr.Conflict("price overlaps another price in this currency", nil),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockProductRepo := repository.NewMockProductRepository(t)
+			mockPriceRepo := repository.NewMockPriceRepository(t)
+
+			// When
+			mockProductRepo.ExpectedCalls = []*mock.Call{
+				mockProductRepo.On("GetOne", mock.Anything, int64(1), model.ReadOptions{}).Return(model.Product{ID: 1}, tc.mockGetOneErr),
+			}
+			if tc.mockCreateRepo.expCall {
+				mockPriceRepo.ExpectedCalls = []*mock.Call{
+					mockPriceRepo.On("Create", mock.Anything, price).Return(tc.mockCreateRepo.output, tc.mockCreateRepo.err),
+				}
+			}
+			serv := NewPriceService(mockProductRepo, mockPriceRepo, repository.NewMockExchangeRateRepository(t), repository.NewMockPriceChangeRepository(t))
+			rs, err := serv.Create(ctx, price)
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
+
+func TestPriceService_Delete(t *testing.T) {
+	type mockDeleteRepo struct {
+		expCall bool
+		err     error
+	}
+
+	tcs := map[string]struct {
+		mockGetOneErr  error
+		mockDeleteRepo mockDeleteRepo
+		expErr         error
+	}{
+		"success": {
+			mockDeleteRepo: mockDeleteRepo{
+				expCall: true,
+			},
+		},
+		"error: product not found": {
+			mockGetOneErr: apperr.NotFound("product", sql.ErrNoRows),
+			expErr:        apperr.NotFound("product", sql.ErrNoRows),
+		},
+		"error: price not found": {
+			mockDeleteRepo: mockDeleteRepo{
+				expCall: true,
+				err:     apperr.NotFound("price", sql.ErrNoRows),
+			},
+			expErr: apperr.NotFound("price", sql.ErrNoRows),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockProductRepo := repository.NewMockProductRepository(t)
+			mockPriceRepo := repository.NewMockPriceRepository(t)
+
+			// When
+			mockProductRepo.ExpectedCalls = []*mock.Call{
+				mockProductRepo.On("GetOne", mock.Anything, int64(1), model.ReadOptions{}).Return(model.Product{ID: 1}, tc.mockGetOneErr),
+			}
+			if tc.mockDeleteRepo.expCall {
+				mockPriceRepo.ExpectedCalls = []*mock.Call{
+					mockPriceRepo.On("Delete", mock.Anything, int64(1), int64(2)).Return(tc.mockDeleteRepo.err),
+				}
+			}
+			serv := NewPriceService(mockProductRepo, mockPriceRepo, repository.NewMockExchangeRateRepository(t), repository.NewMockPriceChangeRepository(t))
+			err := serv.Delete(ctx, 1, 2)
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceService_ApplyDuePriceChanges(t *testing.T) {
+	type mockApplyRepo struct {
+		id     int64
+		output bool
+		err    error
+	}
+
+	at := time.Date(2030, 11, 29, 0, 0, 0, 0, time.UTC)
+
+	fullBatch := make([]model.PriceChange, priceChangeBatchSize)
+	for i := range fullBatch {
+		fullBatch[i] = model.PriceChange{ID: int64(i + 1)}
+	}
+
+	tcs := map[string]struct {
+		mockDue       [][]model.PriceChange
+		mockDueErr    error
+		mockApplyRepo []mockApplyRepo
+		expRes        int
+		expErr        error
+	}{
+		"success": {
+			mockDue: [][]model.PriceChange{{{ID: 1}, {ID: 2}}},
+			mockApplyRepo: []mockApplyRepo{
+				{id: 1, output: true},
+				{id: 2, output: true},
+			},
+			expRes: 2,
+		},
+		"success: applied concurrently": {
+			mockDue: [][]model.PriceChange{{{ID: 1}, {ID: 2}}},
+			mockApplyRepo: []mockApplyRepo{
+				{id: 1, output: false},
+				{id: 2, output: true},
+			},
+			expRes: 1,
+		},
+		"success: nothing due": {
+			mockDue: [][]model.PriceChange{nil},
+		},
+		"success: next batch": {
+			mockDue: [][]model.PriceChange{fullBatch, {{ID: 101}}},
+			mockApplyRepo: func() []mockApplyRepo {
+				applies := make([]mockApplyRepo, 0, priceChangeBatchSize+1)
+				for i := range fullBatch {
+					applies = append(applies, mockApplyRepo{id: int64(i + 1), output: true})
+				}
+				return append(applies, mockApplyRepo{id: 101, output: true})
+			}(),
+			expRes: priceChangeBatchSize + 1,
+		},
+		"error: get due": {
+			mockDue:    [][]model.PriceChange{nil},
+			mockDueErr: errors.New("test"),
+			expErr:     errors.New("test"),
+		},
+		"error: apply": {
+			mockDue: [][]model.PriceChange{{{ID: 1}, {ID: 2}}},
+			mockApplyRepo: []mockApplyRepo{
+				{id: 1, output: true},
+				{id: 2, err: errors.New("test")},
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockPriceChangeRepo := repository.NewMockPriceChangeRepository(t)
+
+			// When
+			for _, due := range tc.mockDue {
+				mockPriceChangeRepo.On("GetDue", mock.Anything, at, priceChangeBatchSize).Return(due, tc.mockDueErr).Once()
+			}
+			for _, apply := range tc.mockApplyRepo {
+				mockPriceChangeRepo.On("Apply", mock.Anything, apply.id, at).Return(apply.output, apply.err).Once()
+			}
+			serv := NewPriceService(repository.NewMockProductRepository(t), repository.NewMockPriceRepository(t), repository.NewMockExchangeRateRepository(t), mockPriceChangeRepo)
+			rs, err := serv.ApplyDuePriceChanges(ctx, at)
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
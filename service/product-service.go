@@ -4,13 +4,23 @@ import (
 	"chi-demo/model"
 	"chi-demo/repository"
 	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
 )
 
+// tracer starts the spans of every service method
+var tracer = otel.Tracer("chi-demo/service")
+
 type ProductService interface {
-	GetOne(ctx context.Context, id int64) (model.Product, error)
-	GetAll(ctx context.Context) ([]model.Product, error)
-	Create(ctx context.Context, product model.Product) error
+	GetOne(ctx context.Context, id int64, opts model.ReadOptions) (model.Product, error)
+	GetAll(ctx context.Context, query model.ProductListQuery) (model.ProductPage, error)
+	Create(ctx context.Context, product model.Product) (model.Product, error)
+	Update(ctx context.Context, product model.Product, note model.ChangeNote) (model.Product, error)
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) (model.Product, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	Search(ctx context.Context, query model.SearchQuery, page model.Page) (model.SearchPage, error)
 }
 
 type ProductServiceImpl struct {
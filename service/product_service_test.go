@@ -1,62 +1,74 @@
 package service
 
 import (
+	"chi-demo/apperr"
 	"chi-demo/model"
 	"chi-demo/repository"
 	"context"
+	"database/sql"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestController_GetProducts(t *testing.T) {
 	type mockGetProductsRepo struct {
 		expCall bool
-		output  []model.Product
+		output  model.ProductPage
 		err     error
 	}
 
 	tcs := map[string]struct {
 		mockGetProductsRepo mockGetProductsRepo
-		expRes              []model.Product
+		expRes              model.ProductPage
 		expErr              error
 	}{
 		"success": {
 			mockGetProductsRepo: mockGetProductsRepo{
 				expCall: true,
-				output: []model.Product{
+				output: model.ProductPage{
+					Products: []model.Product{
+						{
+							ID:    1,
+							Name:  "test",
+							Price: model.Money{Amount: 1, Currency: "USD"},
+						},
+					},
+					Total: 1,
+				},
+			},
+			expRes: model.ProductPage{
+				Products: []model.Product{
 					{
 						ID:    1,
 						Name:  "test",
-						Price: 1,
+						Price: model.Money{Amount: 1, Currency: "USD"},
 					},
 				},
-			},
-			expRes: []model.Product{
-				{
-					ID:    1,
-					Name:  "test",
-					Price: 1,
-				},
+				Total: 1,
 			},
 		},
 		"error": {
 			mockGetProductsRepo: mockGetProductsRepo{
 				expCall: true,
-				output:  nil,
 				err:     errors.New("test"),
 			},
-			expRes: nil,
 			expErr: errors.New("test"),
 		},
 		"empty": {
 			mockGetProductsRepo: mockGetProductsRepo{
 				expCall: true,
-				output:  nil,
 			},
-			expRes: nil,
+			expRes: model.ProductPage{},
 		},
 	}
 
@@ -73,12 +85,135 @@ func TestController_GetProducts(t *testing.T) {
 			// When
 			if tc.mockGetProductsRepo.expCall {
 				mockProductRepo.ExpectedCalls = []*mock.Call{
-					mockProductRepo.On("GetAll", ctx).Return(tc.mockGetProductsRepo.output, tc.mockGetProductsRepo.err),
+					// the repository gets the context of the service span
+					mockProductRepo.On("GetAll", mock.AnythingOfType("*context.valueCtx"), model.ProductListQuery{Limit: 20}).Return(tc.mockGetProductsRepo.output, tc.mockGetProductsRepo.err),
 				}
 			}
 
 			serv := New(mockProductRepo)
-			rs, err := serv.GetAll(ctx)
+			rs, err := serv.GetAll(ctx, model.ProductListQuery{Limit: 20})
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
+
+// spanExporter collects the spans of the whole package, the global provider
+// can only be installed once since the package tracer binds to the first one.
+var (
+	spanExporter    = tracetest.NewInMemoryExporter()
+	installProvider sync.Once
+)
+
+func TestProductService_Tracing(t *testing.T) {
+	installProvider.Do(func() {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter)))
+	})
+
+	tcs := map[string]struct {
+		mockErr   error
+		expStatus codes.Code
+	}{
+		"success": {
+			expStatus: codes.Unset,
+		},
+		"not found": {
+			mockErr:   sql.ErrNoRows,
+			expStatus: codes.Unset,
+		},
+		"error": {
+			mockErr:   errors.New("test"),
+			expStatus: codes.Error,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			spanExporter.Reset()
+			ctx := context.Background()
+			mockProductRepo := repository.NewMockProductRepository(t)
+
+			// When
+			var repoSpan trace.SpanContext
+			mockProductRepo.ExpectedCalls = []*mock.Call{
+				mockProductRepo.On("GetOne", mock.Anything, int64(1), model.ReadOptions{}).
+					Run(func(args mock.Arguments) {
+						repoSpan = trace.SpanContextFromContext(args.Get(0).(context.Context))
+					}).
+					Return(model.Product{}, tc.mockErr),
+			}
+			_, err := New(mockProductRepo).GetOne(ctx, 1, model.ReadOptions{})
+
+			// Then
+			require.Equal(t, tc.mockErr, err)
+			spans := spanExporter.GetSpans()
+			require.Len(t, spans, 1)
+			require.Equal(t, "ProductService.GetOne", spans[0].Name)
+			require.Equal(t, tc.expStatus, spans[0].Status.Code)
+			// the repository call is a child of the service span
+			require.Equal(t, spans[0].SpanContext.SpanID(), repoSpan.SpanID())
+		})
+	}
+}
+
+func TestProductService_Purge(t *testing.T) {
+	type mockPurgeRepo struct {
+		expCall bool
+		output  int64
+		err     error
+	}
+
+	tcs := map[string]struct {
+		givenRetention time.Duration
+		mockPurgeRepo  mockPurgeRepo
+		expRes         int64
+		expErr         error
+	}{
+		"success": {
+			givenRetention: time.Hour,
+			mockPurgeRepo: mockPurgeRepo{
+				expCall: true,
+				output:  2,
+			},
+			expRes: 2,
+		},
+		"error - negative retention": {
+			givenRetention: -time.Hour,
+			expErr:         apperr.Validation(apperr.FieldViolation{Field: "retention", Message: "must not be negative"}),
+		},
+		"error": {
+			givenRetention: time.Hour,
+			mockPurgeRepo: mockPurgeRepo{
+				expCall: true,
+				err:     errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockProductRepo := repository.NewMockProductRepository(t)
+
+			// When
+			if tc.mockPurgeRepo.expCall {
+				cutoff := mock.MatchedBy(func(deletedBefore time.Time) bool {
+					return time.Since(deletedBefore) >= tc.givenRetention
+				})
+				mockProductRepo.ExpectedCalls = []*mock.Call{
+					mockProductRepo.On("Purge", mock.Anything, cutoff).Return(tc.mockPurgeRepo.output, tc.mockPurgeRepo.err),
+				}
+			}
+			rs, err := New(mockProductRepo).Purge(ctx, tc.givenRetention)
 
 			// Then
 			if tc.expErr != nil {
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/tracing"
+	"context"
+	"time"
+)
+
+// DefaultPurgeRetention is how long a soft-deleted product is kept before it
+// becomes eligible for purging when no retention window is given.
+const DefaultPurgeRetention = 30 * 24 * time.Hour
+
+// Purge hard-deletes products that have been soft-deleted for longer than
+// the retention window.
+func (productServiceImpl ProductServiceImpl) Purge(ctx context.Context, retention time.Duration) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.Purge")
+	defer tracing.End(span, &err)
+
+	if retention < 0 {
+		return 0, apperr.Validation(apperr.FieldViolation{Field: "retention", Message: "must not be negative"})
+	}
+
+	return productServiceImpl.productRepository.Purge(ctx, time.Now().Add(-retention))
+}
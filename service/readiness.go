@@ -0,0 +1,81 @@
+package service
+
+import (
+	"chi-demo/log"
+	"chi-demo/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var errShuttingDown = errors.New("shutting down")
+
+// Readiness runs every check, even after one failed, so the report shows all
+// the broken dependencies. /readyz is public, so a failed check only reports
+// a fixed message and the error itself is logged.
+func (i HealthServiceImpl) Readiness(ctx context.Context) model.HealthReport {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	report := model.HealthReport{Status: model.HealthStatusOK}
+	for _, c := range []struct {
+		name    string
+		failure string
+		check   func(ctx context.Context) error
+	}{
+		{name: "shutdown", failure: "shutting down", check: i.checkShutdown},
+		{name: "database", failure: "database unavailable", check: i.healthRepository.Ping},
+		{name: "migrations", failure: "schema version mismatch", check: i.checkMigrations},
+	} {
+		result := runCheck(ctx, c.name, c.failure, c.check)
+		if result.Status != model.HealthStatusOK {
+			report.Status = model.HealthStatusFail
+		}
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report
+}
+
+func (i HealthServiceImpl) Drain() {
+	i.draining.Store(true)
+}
+
+func (i HealthServiceImpl) checkShutdown(ctx context.Context) error {
+	if i.draining.Load() {
+		return errShuttingDown
+	}
+	return nil
+}
+
+func (i HealthServiceImpl) checkMigrations(ctx context.Context) error {
+	current, err := i.healthRepository.GetSchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if current.Dirty {
+		return fmt.Errorf("migration %d is dirty", current.Version)
+	}
+	// a newer schema is fine, it happens while a deploy rolls out
+	if current.Version < i.schemaVersion {
+		return fmt.Errorf("schema at version %d, expected %d", current.Version, i.schemaVersion)
+	}
+	return nil
+}
+
+func runCheck(ctx context.Context, name, failure string, check func(ctx context.Context) error) model.HealthCheck {
+	start := time.Now()
+	err := check(ctx)
+	result := model.HealthCheck{
+		Name:      name,
+		Status:    model.HealthStatusOK,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = model.HealthStatusFail
+		result.Error = failure
+		log.FromContext(ctx).Warn("readiness check failed", "check", name, "error", err)
+	}
+	return result
+}
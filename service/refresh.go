@@ -0,0 +1,60 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"errors"
+	"time"
+)
+
+// Refresh rotates a refresh token: the presented token is revoked and a new
+// pair is issued in the same family. Presenting an already revoked token
+// means it leaked, so the whole family is revoked. The user is read again so
+// that role changes apply from the next refresh.
+func (userServiceImpl UserServiceImpl) Refresh(ctx context.Context, refreshToken string) (model.AuthToken, error) {
+	token, err := userServiceImpl.tokenRepository.GetRefreshTokenByHash(ctx, hashToken(refreshToken))
+	var notFound *apperr.NotFoundError
+	if errors.As(err, &notFound) {
+		return model.AuthToken{}, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return model.AuthToken{}, err
+	}
+
+	if !token.RevokedAt.IsZero() {
+		return model.AuthToken{}, userServiceImpl.revokeFamily(ctx, token.FamilyID)
+	}
+
+	if !token.ExpiresAt.After(time.Now()) {
+		return model.AuthToken{}, ErrInvalidRefreshToken
+	}
+
+	user, err := userServiceImpl.userRepository.GetByID(ctx, token.UserID)
+	if errors.As(err, &notFound) {
+		// the user was deleted after the token was issued
+		return model.AuthToken{}, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return model.AuthToken{}, err
+	}
+
+	err = userServiceImpl.tokenRepository.RevokeRefreshToken(ctx, token.ID)
+	if errors.Is(err, repository.ErrAlreadyRevoked) {
+		// lost a race against another use of the same token
+		return model.AuthToken{}, userServiceImpl.revokeFamily(ctx, token.FamilyID)
+	}
+	if err != nil {
+		return model.AuthToken{}, err
+	}
+
+	return userServiceImpl.issueTokens(ctx, user, token.FamilyID)
+}
+
+func (userServiceImpl UserServiceImpl) revokeFamily(ctx context.Context, familyID int64) error {
+	if err := userServiceImpl.tokenRepository.RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+		return err
+	}
+	return ErrInvalidRefreshToken
+}
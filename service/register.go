@@ -0,0 +1,23 @@
+package service
+
+import (
+	"chi-demo/model"
+	"context"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Register creates a user, storing only the bcrypt hash of its password.
+// Self-registered users are always viewers.
+func (userServiceImpl UserServiceImpl) Register(ctx context.Context, user model.User) (model.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	return userServiceImpl.userRepository.Create(ctx, model.User{
+		Username:     user.Username,
+		PasswordHash: string(hash),
+		Role:         model.RoleViewer,
+	})
+}
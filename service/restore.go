@@ -0,0 +1,14 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+func (productServiceImpl ProductServiceImpl) Restore(ctx context.Context, id int64) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.Restore")
+	defer tracing.End(span, &err)
+
+	return productServiceImpl.productRepository.Restore(ctx, id)
+}
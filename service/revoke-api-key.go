@@ -0,0 +1,9 @@
+package service
+
+import (
+	"context"
+)
+
+func (apiKeyServiceImpl APIKeyServiceImpl) Revoke(ctx context.Context, id int64) error {
+	return apiKeyServiceImpl.apiKeyRepository.Revoke(ctx, id)
+}
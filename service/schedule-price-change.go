@@ -0,0 +1,27 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+	"time"
+)
+
+// SchedulePriceChange stores a price change of a live product to be applied
+// by the scheduler at change.EffectiveAt. Immediate changes go through the
+// product update.
+func (priceServiceImpl PriceServiceImpl) SchedulePriceChange(ctx context.Context, change model.PriceChange) (_ model.PriceChange, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.SchedulePriceChange")
+	defer tracing.End(span, &err)
+
+	if !change.EffectiveAt.After(time.Now()) {
+		return model.PriceChange{}, apperr.Validation(apperr.FieldViolation{Field: "effective_at", Message: "must be in the future"})
+	}
+
+	if _, err := priceServiceImpl.productRepository.GetOne(ctx, change.ProductID, model.ReadOptions{}); err != nil {
+		return model.PriceChange{}, err
+	}
+
+	return priceServiceImpl.priceChangeRepository.Schedule(ctx, change)
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+func (productServiceImpl ProductServiceImpl) Search(ctx context.Context, query model.SearchQuery, page model.Page) (_ model.SearchPage, err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.Search")
+	defer tracing.End(span, &err)
+
+	return productServiceImpl.productRepository.Search(ctx, query, page)
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// SetExchangeRates replaces every exchange rate with rates, currencies left
+// out can no longer be converted to.
+func (priceServiceImpl PriceServiceImpl) SetExchangeRates(ctx context.Context, rates model.ExchangeRates) (_ model.ExchangeRates, err error) {
+	ctx, span := tracer.Start(ctx, "PriceService.SetExchangeRates")
+	defer tracing.End(span, &err)
+
+	return priceServiceImpl.exchangeRateRepository.Replace(ctx, rates)
+}
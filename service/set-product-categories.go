@@ -0,0 +1,24 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// SetProductCategories assigns a live product to exactly the given
+// categories and returns them.
+func (categoryServiceImpl CategoryServiceImpl) SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64) (_ []model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.SetProductCategories")
+	defer tracing.End(span, &err)
+
+	if _, err := categoryServiceImpl.productRepository.GetOne(ctx, productID, model.ReadOptions{}); err != nil {
+		return nil, err
+	}
+
+	if err := categoryServiceImpl.categoryRepository.SetProductCategories(ctx, productID, categoryIDs); err != nil {
+		return nil, err
+	}
+
+	return categoryServiceImpl.categoryRepository.GetByProduct(ctx, productID)
+}
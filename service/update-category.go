@@ -0,0 +1,16 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// Update renames a category and moves it with its subtree under another
+// parent, or to the root when ParentID is zero.
+func (categoryServiceImpl CategoryServiceImpl) Update(ctx context.Context, category model.Category) (_ model.Category, err error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.Update")
+	defer tracing.End(span, &err)
+
+	return categoryServiceImpl.categoryRepository.Update(ctx, category)
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"chi-demo/model"
+	"chi-demo/tracing"
+	"context"
+)
+
+// Update replaces a product, a changed price is recorded in the price
+// history with note.
+func (productServiceImpl ProductServiceImpl) Update(ctx context.Context, product model.Product, note model.ChangeNote) (_ model.Product, err error) {
+	ctx, span := tracer.Start(ctx, "ProductService.Update")
+	defer tracing.End(span, &err)
+
+	return productServiceImpl.productRepository.Update(ctx, product, note)
+}
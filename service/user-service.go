@@ -0,0 +1,48 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/auth"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"time"
+)
+
+const (
+	// AccessTokenTTL is kept short since access tokens are only revocable
+	// through the denylist
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidCredentials is returned by Login for an unknown username or a
+	// wrong password, without telling which.
+	ErrInvalidCredentials = apperr.Unauthorized("invalid username or password")
+	// ErrInvalidRefreshToken is returned by Refresh for an unknown, expired or
+	// reused refresh token.
+	ErrInvalidRefreshToken = apperr.Unauthorized("invalid refresh token")
+)
+
+type UserService interface {
+	Register(ctx context.Context, user model.User) (model.User, error)
+	Login(ctx context.Context, username, password string) (model.AuthToken, error)
+	Refresh(ctx context.Context, refreshToken string) (model.AuthToken, error)
+	Logout(ctx context.Context, userID int64, jti string, expiresAt time.Time, refreshToken string) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+type UserServiceImpl struct {
+	userRepository  repository.UserRepository
+	tokenRepository repository.TokenRepository
+	tokenAuth       *auth.JWTAuth
+}
+
+func NewUserService(userRepository repository.UserRepository, tokenRepository repository.TokenRepository, tokenAuth *auth.JWTAuth) UserService {
+	return UserServiceImpl{
+		userRepository:  userRepository,
+		tokenRepository: tokenRepository,
+		tokenAuth:       tokenAuth,
+	}
+}
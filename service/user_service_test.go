@@ -0,0 +1,460 @@
+package service
+
+import (
+	"chi-demo/apperr"
+	"chi-demo/auth"
+	"chi-demo/model"
+	"chi-demo/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTokenAuth(t *testing.T) *auth.JWTAuth {
+	key, err := auth.NewSecretKey("test", []byte("secret"))
+	require.NoError(t, err)
+	tokenAuth, err := auth.New(key)
+	require.NoError(t, err)
+	return tokenAuth
+}
+
+func TestUserService_Register(t *testing.T) {
+	type mockCreateRepo struct {
+		expCall bool
+		output  model.User
+		err     error
+	}
+
+	tcs := map[string]struct {
+		mockCreateRepo mockCreateRepo
+		expRes         model.User
+		expErr         error
+	}{
+		"success": {
+			mockCreateRepo: mockCreateRepo{
+				expCall: true,
+				output: model.User{
+					ID:       1,
+					Username: "test",
+				},
+			},
+			expRes: model.User{
+				ID:       1,
+				Username: "test",
+			},
+		},
+		"error": {
+			mockCreateRepo: mockCreateRepo{
+				expCall: true,
+				err:     repository.ErrUsernameTaken,
+			},
+			expErr: repository.ErrUsernameTaken,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockUserRepo := repository.NewMockUserRepository(t)
+
+			// When
+			if tc.mockCreateRepo.expCall {
+				// the password must only reach the repository hashed
+				hashed := mock.MatchedBy(func(user model.User) bool {
+					return user.Username == "test" && user.Password == "" && user.Role == model.RoleViewer &&
+						bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("password")) == nil
+				})
+				mockUserRepo.ExpectedCalls = []*mock.Call{
+					mockUserRepo.On("Create", ctx, hashed).Return(tc.mockCreateRepo.output, tc.mockCreateRepo.err),
+				}
+			}
+
+			serv := NewUserService(mockUserRepo, repository.NewMockTokenRepository(t), newTokenAuth(t))
+			rs, err := serv.Register(ctx, model.User{
+				Username: "test",
+				Password: "password",
+			})
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, rs)
+			}
+		})
+	}
+}
+
+func TestUserService_Login(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	type mockGetByUsernameRepo struct {
+		output model.User
+		err    error
+	}
+
+	tcs := map[string]struct {
+		givenPassword         string
+		mockGetByUsernameRepo mockGetByUsernameRepo
+		expCreateRefreshToken bool
+		expErr                error
+	}{
+		"success": {
+			givenPassword: "password",
+			mockGetByUsernameRepo: mockGetByUsernameRepo{
+				output: model.User{
+					ID:           1,
+					Username:     "test",
+					PasswordHash: string(hash),
+					Role:         model.RoleEditor,
+				},
+			},
+			expCreateRefreshToken: true,
+		},
+		"error: wrong password": {
+			givenPassword: "wrong password",
+			mockGetByUsernameRepo: mockGetByUsernameRepo{
+				output: model.User{
+					ID:           1,
+					Username:     "test",
+					PasswordHash: string(hash),
+				},
+			},
+			expErr: ErrInvalidCredentials,
+		},
+		"error: unknown user": {
+			givenPassword: "password",
+			mockGetByUsernameRepo: mockGetByUsernameRepo{
+				err: apperr.NotFound("user", sql.ErrNoRows),
+			},
+			expErr: ErrInvalidCredentials,
+		},
+		"error: db failed": {
+			givenPassword: "password",
+			mockGetByUsernameRepo: mockGetByUsernameRepo{
+				err: errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			tokenAuth := newTokenAuth(t)
+			mockUserRepo := repository.NewMockUserRepository(t)
+			mockTokenRepo := repository.NewMockTokenRepository(t)
+
+			// When
+			mockUserRepo.ExpectedCalls = []*mock.Call{
+				mockUserRepo.On("GetByUsername", ctx, "test").Return(tc.mockGetByUsernameRepo.output, tc.mockGetByUsernameRepo.err),
+			}
+			if tc.expCreateRefreshToken {
+				// a new login starts a new family
+				newFamily := mock.MatchedBy(func(token model.RefreshToken) bool {
+					return token.UserID == 1 && token.FamilyID == 0 && token.TokenHash != ""
+				})
+				mockTokenRepo.ExpectedCalls = []*mock.Call{
+					mockTokenRepo.On("CreateRefreshToken", ctx, newFamily).Return(model.RefreshToken{ID: 10, FamilyID: 10}, nil),
+				}
+			}
+
+			serv := NewUserService(mockUserRepo, mockTokenRepo, tokenAuth)
+			rs, err := serv.Login(ctx, "test", tc.givenPassword)
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Bearer", rs.TokenType)
+				require.EqualValues(t, AccessTokenTTL.Seconds(), rs.ExpiresIn)
+				require.NotEmpty(t, rs.RefreshToken)
+				token, err := tokenAuth.Decode(rs.AccessToken)
+				require.NoError(t, err)
+				userID, _ := token.Get("user_id")
+				require.Equal(t, "1", userID)
+				role, _ := token.Get("role")
+				require.Equal(t, "editor", role)
+				require.NotEmpty(t, token.JwtID())
+				require.WithinDuration(t, time.Now().Add(AccessTokenTTL), token.Expiration(), time.Minute)
+			}
+		})
+	}
+}
+
+func TestUserService_Refresh(t *testing.T) {
+	type mockGetRefreshTokenRepo struct {
+		output model.RefreshToken
+		err    error
+	}
+
+	type mockRevokeRefreshTokenRepo struct {
+		expCall bool
+		err     error
+	}
+
+	type mockGetByIDRepo struct {
+		expCall bool
+		output  model.User
+		err     error
+	}
+
+	tcs := map[string]struct {
+		mockGetRefreshTokenRepo    mockGetRefreshTokenRepo
+		mockGetByIDRepo            mockGetByIDRepo
+		mockRevokeRefreshTokenRepo mockRevokeRefreshTokenRepo
+		expRevokeFamily            bool
+		expCreateRefreshToken      bool
+		expErr                     error
+	}{
+		"success": {
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				output: model.RefreshToken{
+					ID:        11,
+					UserID:    1,
+					FamilyID:  10,
+					ExpiresAt: time.Now().Add(time.Hour),
+				},
+			},
+			mockGetByIDRepo: mockGetByIDRepo{
+				expCall: true,
+				output: model.User{
+					ID:   1,
+					Role: model.RoleAdmin,
+				},
+			},
+			mockRevokeRefreshTokenRepo: mockRevokeRefreshTokenRepo{
+				expCall: true,
+			},
+			expCreateRefreshToken: true,
+		},
+		"error: unknown token": {
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				err: apperr.NotFound("refresh token", sql.ErrNoRows),
+			},
+			expErr: ErrInvalidRefreshToken,
+		},
+		"error: deleted user": {
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				output: model.RefreshToken{
+					ID:        11,
+					UserID:    1,
+					FamilyID:  10,
+					ExpiresAt: time.Now().Add(time.Hour),
+				},
+			},
+			mockGetByIDRepo: mockGetByIDRepo{
+				expCall: true,
+				err:     apperr.NotFound("user", sql.ErrNoRows),
+			},
+			expErr: ErrInvalidRefreshToken,
+		},
+		"error: expired token": {
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				output: model.RefreshToken{
+					ID:        11,
+					UserID:    1,
+					FamilyID:  10,
+					ExpiresAt: time.Now().Add(-time.Hour),
+				},
+			},
+			expErr: ErrInvalidRefreshToken,
+		},
+		"error: reused token revokes the family": {
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				output: model.RefreshToken{
+					ID:        11,
+					UserID:    1,
+					FamilyID:  10,
+					ExpiresAt: time.Now().Add(time.Hour),
+					RevokedAt: time.Now().Add(-time.Minute),
+				},
+			},
+			expRevokeFamily: true,
+			expErr:          ErrInvalidRefreshToken,
+		},
+		"error: concurrent use revokes the family": {
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				output: model.RefreshToken{
+					ID:        11,
+					UserID:    1,
+					FamilyID:  10,
+					ExpiresAt: time.Now().Add(time.Hour),
+				},
+			},
+			mockGetByIDRepo: mockGetByIDRepo{
+				expCall: true,
+				output: model.User{
+					ID:   1,
+					Role: model.RoleAdmin,
+				},
+			},
+			mockRevokeRefreshTokenRepo: mockRevokeRefreshTokenRepo{
+				expCall: true,
+				err:     repository.ErrAlreadyRevoked,
+			},
+			expRevokeFamily: true,
+			expErr:          ErrInvalidRefreshToken,
+		},
+		"error: db failed": {
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				err: errors.New("test"),
+			},
+			expErr: errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			tokenAuth := newTokenAuth(t)
+			mockUserRepo := repository.NewMockUserRepository(t)
+			mockTokenRepo := repository.NewMockTokenRepository(t)
+
+			// When
+			mockTokenRepo.ExpectedCalls = []*mock.Call{
+				mockTokenRepo.On("GetRefreshTokenByHash", ctx, hashToken("refresh")).Return(tc.mockGetRefreshTokenRepo.output, tc.mockGetRefreshTokenRepo.err),
+			}
+			if tc.mockGetByIDRepo.expCall {
+				mockUserRepo.ExpectedCalls = []*mock.Call{
+					mockUserRepo.On("GetByID", ctx, int64(1)).Return(tc.mockGetByIDRepo.output, tc.mockGetByIDRepo.err),
+				}
+			}
+			if tc.mockRevokeRefreshTokenRepo.expCall {
+				mockTokenRepo.On("RevokeRefreshToken", ctx, int64(11)).Return(tc.mockRevokeRefreshTokenRepo.err)
+			}
+			if tc.expRevokeFamily {
+				mockTokenRepo.On("RevokeRefreshTokenFamily", ctx, int64(10)).Return(nil)
+			}
+			if tc.expCreateRefreshToken {
+				// the rotated token stays in the family
+				sameFamily := mock.MatchedBy(func(token model.RefreshToken) bool {
+					return token.UserID == 1 && token.FamilyID == 10 && token.TokenHash != hashToken("refresh")
+				})
+				mockTokenRepo.On("CreateRefreshToken", ctx, sameFamily).Return(model.RefreshToken{ID: 12, FamilyID: 10}, nil)
+			}
+
+			serv := NewUserService(mockUserRepo, mockTokenRepo, tokenAuth)
+			rs, err := serv.Refresh(ctx, "refresh")
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.NotEqual(t, "refresh", rs.RefreshToken)
+				token, err := tokenAuth.Decode(rs.AccessToken)
+				require.NoError(t, err)
+				userID, _ := token.Get("user_id")
+				require.Equal(t, "1", userID)
+				// the role is read again on refresh
+				role, _ := token.Get("role")
+				require.Equal(t, "admin", role)
+			}
+		})
+	}
+}
+
+func TestUserService_Logout(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute)
+
+	type mockGetRefreshTokenRepo struct {
+		expCall bool
+		output  model.RefreshToken
+		err     error
+	}
+
+	tcs := map[string]struct {
+		givenRefreshToken       string
+		mockDenyAccessTokenErr  error
+		mockGetRefreshTokenRepo mockGetRefreshTokenRepo
+		expRevokeFamily         bool
+		expErr                  error
+	}{
+		"success: access token only": {},
+		"success: with refresh token": {
+			givenRefreshToken: "refresh",
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				expCall: true,
+				output: model.RefreshToken{
+					ID:       11,
+					UserID:   1,
+					FamilyID: 10,
+				},
+			},
+			expRevokeFamily: true,
+		},
+		"error: refresh token of another user": {
+			givenRefreshToken: "refresh",
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				expCall: true,
+				output: model.RefreshToken{
+					ID:       11,
+					UserID:   2,
+					FamilyID: 10,
+				},
+			},
+			expErr: ErrInvalidRefreshToken,
+		},
+		"error: unknown refresh token": {
+			givenRefreshToken: "refresh",
+			mockGetRefreshTokenRepo: mockGetRefreshTokenRepo{
+				expCall: true,
+				err:     apperr.NotFound("refresh token", sql.ErrNoRows),
+			},
+			expErr: ErrInvalidRefreshToken,
+		},
+		"error: db failed": {
+			mockDenyAccessTokenErr: errors.New("test"),
+			expErr:                 errors.New("test"),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			mockTokenRepo := repository.NewMockTokenRepository(t)
+
+			// When
+			mockTokenRepo.ExpectedCalls = []*mock.Call{
+				mockTokenRepo.On("DenyAccessToken", ctx, "jti", expiresAt).Return(tc.mockDenyAccessTokenErr),
+			}
+			if tc.mockGetRefreshTokenRepo.expCall {
+				mockTokenRepo.On("GetRefreshTokenByHash", ctx, hashToken(tc.givenRefreshToken)).Return(tc.mockGetRefreshTokenRepo.output, tc.mockGetRefreshTokenRepo.err)
+			}
+			if tc.expRevokeFamily {
+				mockTokenRepo.On("RevokeRefreshTokenFamily", ctx, int64(10)).Return(nil)
+			}
+
+			serv := NewUserService(repository.NewMockUserRepository(t), mockTokenRepo, newTokenAuth(t))
+			err := serv.Logout(ctx, 1, "jti", expiresAt, tc.givenRefreshToken)
+
+			// Then
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDummyPasswordHash(t *testing.T) {
+	// the dummy compare must cost as much as comparing a registered password
+	cost, err := bcrypt.Cost([]byte(dummyPasswordHash))
+	require.NoError(t, err)
+	require.Equal(t, bcrypt.DefaultCost, cost)
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"chi-demo/db"
+	"context"
+	"database/sql"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// executor wraps a db.ContextExecutor with a client span per statement. The
+// methods without a context have no parent span to attach to and are left
+// untraced.
+type executor struct {
+	db.ContextExecutor
+	tracer trace.Tracer
+}
+
+func WrapExecutor(exec db.ContextExecutor) db.ContextExecutor {
+	return executor{
+		ContextExecutor: exec,
+		tracer:          otel.Tracer(instrumentationName),
+	}
+}
+
+func (e executor) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := "SQL"
+	if fields := strings.Fields(query); len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+	return e.tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperation(operation),
+			semconv.DBStatement(query),
+		),
+	)
+}
+
+func (e executor) ExecContext(ctx context.Context, query string, args ...interface{}) (_ sql.Result, err error) {
+	ctx, span := e.start(ctx, query)
+	defer End(span, &err)
+	return e.ContextExecutor.ExecContext(ctx, query, args...)
+}
+
+func (e executor) QueryContext(ctx context.Context, query string, args ...interface{}) (_ *sql.Rows, err error) {
+	ctx, span := e.start(ctx, query)
+	defer End(span, &err)
+	return e.ContextExecutor.QueryContext(ctx, query, args...)
+}
+
+func (e executor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := e.start(ctx, query)
+	row := e.ContextExecutor.QueryRowContext(ctx, query, args...)
+	err := row.Err()
+	End(span, &err)
+	return row
+}
@@ -0,0 +1,224 @@
+package tracing
+
+import (
+	"chi-demo/config"
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans installs a provider keeping the ended spans in memory.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestInit(t *testing.T) {
+	tcs := map[string]struct {
+		givenExporter string
+		expErr        string
+	}{
+		"none":   {givenExporter: "none"},
+		"stdout": {givenExporter: "stdout"},
+		"otlp":   {givenExporter: "otlp"},
+		"err - unknown exporter": {
+			givenExporter: "jaeger",
+			expErr:        `tracing: unknown exporter "jaeger"`,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			cfg := config.Default().Tracing
+			cfg.Exporter = tc.givenExporter
+			previous := otel.GetTracerProvider()
+			t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+			// When
+			shutdown, err := Init(context.Background(), cfg)
+
+			// Then
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, shutdown(context.Background()))
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	type args struct {
+		givenTraceparent string
+		givenPath        string
+		expName          string
+		expStatusCode    int64
+		expStatus        codes.Code
+	}
+
+	tcs := map[string]args{
+		"success - continues the caller trace": {
+			givenTraceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			givenPath:        "/products/1",
+			expName:          "GET /products/{id}",
+			expStatusCode:    http.StatusOK,
+			expStatus:        codes.Unset,
+		},
+		"success - new trace": {
+			givenPath:     "/products/1",
+			expName:       "GET /products/{id}",
+			expStatusCode: http.StatusOK,
+			expStatus:     codes.Unset,
+		},
+		"server error": {
+			givenPath:     "/products/0",
+			expName:       "GET /products/{id}",
+			expStatusCode: http.StatusInternalServerError,
+			expStatus:     codes.Error,
+		},
+		"unmatched route": {
+			givenPath:     "/unknown",
+			expName:       "GET",
+			expStatusCode: http.StatusNotFound,
+			expStatus:     codes.Unset,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			recorder := recordSpans(t)
+			_, err := Init(context.Background(), config.TracingConfig{Exporter: "none"})
+			require.NoError(t, err)
+
+			var handlerSpan trace.SpanContext
+			r := chi.NewRouter()
+			r.Use(Middleware)
+			r.Get("/products/{id}", func(w http.ResponseWriter, r *http.Request) {
+				handlerSpan = trace.SpanContextFromContext(r.Context())
+				if chi.URLParam(r, "id") == "0" {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			})
+			req := httptest.NewRequest(http.MethodGet, tc.givenPath, nil)
+			if tc.givenTraceparent != "" {
+				req.Header.Set("traceparent", tc.givenTraceparent)
+			}
+			res := httptest.NewRecorder()
+
+			// When
+			r.ServeHTTP(res, req)
+
+			// Then
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			require.Equal(t, tc.expName, span.Name())
+			require.Equal(t, trace.SpanKindServer, span.SpanKind())
+			require.Equal(t, tc.expStatusCode, attributes(span)["http.status_code"].AsInt64())
+			require.Equal(t, tc.expStatus, span.Status().Code)
+			if tc.givenTraceparent != "" {
+				require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+				require.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+				require.True(t, span.Parent().IsRemote())
+			} else {
+				require.False(t, span.Parent().IsValid())
+			}
+			if handlerSpan.IsValid() {
+				// the handler runs inside the server span
+				require.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+			}
+		})
+	}
+}
+
+func TestWrapExecutor(t *testing.T) {
+	type args struct {
+		givenErr     error
+		expOperation string
+		expStatus    codes.Code
+	}
+
+	tcs := map[string]args{
+		"success": {
+			expOperation: "SELECT",
+			expStatus:    codes.Unset,
+		},
+		"no rows": {
+			givenErr:     sql.ErrNoRows,
+			expOperation: "SELECT",
+			expStatus:    codes.Unset,
+		},
+		"error": {
+			givenErr:     errors.New("test"),
+			expOperation: "SELECT",
+			expStatus:    codes.Error,
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			recorder := recordSpans(t)
+			dbMock, sqlMock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer dbMock.Close()
+			expected := sqlMock.ExpectQuery("select name from product")
+			if tc.givenErr != nil {
+				expected.WillReturnError(tc.givenErr)
+			} else {
+				expected.WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("shoes"))
+			}
+
+			ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+
+			// When
+			rows, err := WrapExecutor(dbMock).QueryContext(ctx, "select name from product")
+			parent.End()
+
+			// Then
+			if tc.givenErr != nil {
+				require.Equal(t, tc.givenErr, err)
+			} else {
+				require.NoError(t, err)
+				rows.Close()
+			}
+			spans := recorder.Ended()
+			require.Len(t, spans, 2)
+			span := spans[0]
+			require.Equal(t, tc.expOperation, span.Name())
+			require.Equal(t, trace.SpanKindClient, span.SpanKind())
+			require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			require.Equal(t, "postgresql", attributes(span)["db.system"].AsString())
+			require.Equal(t, "select name from product", attributes(span)["db.statement"].AsString())
+			require.Equal(t, tc.expStatus, span.Status().Code)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Package validate checks request payloads with rule builders and reports
+// every broken rule at once as an *apperr.ValidationError.
+package validate
+
+import (
+	"chi-demo/apperr"
+	"cmp"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Rule checks a value, it returns the violation message or "" when the value
+// is valid.
+type Rule func() string
+
+// Validator collects the violations of a payload.
+type Validator struct {
+	violations []apperr.FieldViolation
+}
+
+// Field runs rules in order and records the first one broken by field, so
+// an empty name is not also reported as too short.
+func (v *Validator) Field(field string, rules ...Rule) {
+	for _, rule := range rules {
+		if message := rule(); message != "" {
+			v.violations = append(v.violations, apperr.FieldViolation{
+				Field:   field,
+				Message: message,
+			})
+			return
+		}
+	}
+}
+
+// Err returns the violations recorded so far, or nil when there is none.
+func (v *Validator) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return apperr.Validation(v.violations...)
+}
+
+// Required rejects the zero value.
+func Required[T comparable](value T) Rule {
+	return func() string {
+		var zero T
+		if value == zero {
+			return "is required"
+		}
+		return ""
+	}
+}
+
+// MaxLength rejects strings longer than n characters.
+func MaxLength(value string, n int) Rule {
+	return func() string {
+		if utf8.RuneCountInString(value) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+		return ""
+	}
+}
+
+// Min rejects values below min.
+func Min[T cmp.Ordered](value, min T) Rule {
+	return func() string {
+		if value < min {
+			return fmt.Sprintf("must be at least %v", min)
+		}
+		return ""
+	}
+}
+
+// Max rejects values above max.
+func Max[T cmp.Ordered](value, max T) Rule {
+	return func() string {
+		if value > max {
+			return fmt.Sprintf("must be at most %v", max)
+		}
+		return ""
+	}
+}
+
+// OneOf rejects values outside allowed.
+func OneOf[T comparable](value T, allowed ...T) Rule {
+	return func() string {
+		for _, a := range allowed {
+			if value == a {
+				return ""
+			}
+		}
+		values := make([]string, len(allowed))
+		for i, a := range allowed {
+			values[i] = fmt.Sprint(a)
+		}
+		return "must be one of " + strings.Join(values, ", ")
+	}
+}
@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"chi-demo/apperr"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	type args struct {
+		givenName  string
+		givenPrice int
+		givenUnit  string
+		expErr     error
+	}
+
+	tcs := map[string]args{
+		"success": {
+			givenName:  "test",
+			givenPrice: 1,
+		},
+		"success - multibyte name at the limit": {
+			givenName:  "ééééé",
+			givenPrice: 1,
+		},
+		"err - every field reported": {
+			givenName:  "",
+			givenPrice: -1,
+			expErr: apperr.Validation(
+				apperr.FieldViolation{Field: "name", Message: "is required"},
+				apperr.FieldViolation{Field: "price", Message: "must be at least 1"},
+			),
+		},
+		"err - first rule only": {
+			givenName:  "",
+			givenPrice: 0,
+			expErr: apperr.Validation(
+				apperr.FieldViolation{Field: "name", Message: "is required"},
+				apperr.FieldViolation{Field: "price", Message: "is required"},
+			),
+		},
+		"err - bounds": {
+			givenName:  "a",
+			givenPrice: 11,
+			expErr: apperr.Validation(
+				apperr.FieldViolation{Field: "price", Message: "must be at most 10"},
+			),
+		},
+		"err - too long": {
+			givenName:  "éééééé",
+			givenPrice: 1,
+			expErr: apperr.Validation(
+				apperr.FieldViolation{Field: "name", Message: "must be at most 5 characters"},
+			),
+		},
+		"err - not allowed": {
+			givenName:  "test",
+			givenPrice: 1,
+			givenUnit:  "kg",
+			expErr: apperr.Validation(
+				apperr.FieldViolation{Field: "unit", Message: "must be one of g, lb"},
+			),
+		},
+	}
+
+	for scenario, tc := range tcs {
+		t.Run(scenario, func(t *testing.T) {
+			// Given
+			var v Validator
+
+			// When
+			v.Field("name", Required(tc.givenName), MaxLength(tc.givenName, 5))
+			v.Field("price", Required(tc.givenPrice), Min(tc.givenPrice, 1), Max(tc.givenPrice, 10))
+			if tc.givenUnit != "" {
+				v.Field("unit", OneOf(tc.givenUnit, "g", "lb"))
+			}
+			err := v.Err()
+
+			// Then
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}